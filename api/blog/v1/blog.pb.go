@@ -11,6 +11,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,12 +25,87 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ArticleStatus is where an article is in the publishing workflow. Allowed moves:
+// draft -> in_review, scheduled, published, archived
+// in_review -> draft, scheduled, published, archived
+// scheduled -> draft, published, archived
+// published -> draft, archived
+// archived -> draft
+type ArticleStatus int32
+
+const (
+	ArticleStatus_ARTICLE_STATUS_UNSPECIFIED ArticleStatus = 0
+	ArticleStatus_ARTICLE_STATUS_DRAFT       ArticleStatus = 1
+	ArticleStatus_ARTICLE_STATUS_IN_REVIEW   ArticleStatus = 2
+	ArticleStatus_ARTICLE_STATUS_SCHEDULED   ArticleStatus = 3 // published automatically at publish_at
+	ArticleStatus_ARTICLE_STATUS_PUBLISHED   ArticleStatus = 4 // the only status visible to GetArticle and the default of ListArticle
+	ArticleStatus_ARTICLE_STATUS_ARCHIVED    ArticleStatus = 5
+)
+
+// Enum value maps for ArticleStatus.
+var (
+	ArticleStatus_name = map[int32]string{
+		0: "ARTICLE_STATUS_UNSPECIFIED",
+		1: "ARTICLE_STATUS_DRAFT",
+		2: "ARTICLE_STATUS_IN_REVIEW",
+		3: "ARTICLE_STATUS_SCHEDULED",
+		4: "ARTICLE_STATUS_PUBLISHED",
+		5: "ARTICLE_STATUS_ARCHIVED",
+	}
+	ArticleStatus_value = map[string]int32{
+		"ARTICLE_STATUS_UNSPECIFIED": 0,
+		"ARTICLE_STATUS_DRAFT":       1,
+		"ARTICLE_STATUS_IN_REVIEW":   2,
+		"ARTICLE_STATUS_SCHEDULED":   3,
+		"ARTICLE_STATUS_PUBLISHED":   4,
+		"ARTICLE_STATUS_ARCHIVED":    5,
+	}
+)
+
+func (x ArticleStatus) Enum() *ArticleStatus {
+	p := new(ArticleStatus)
+	*p = x
+	return p
+}
+
+func (x ArticleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArticleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_blog_v1_blog_proto_enumTypes[0].Descriptor()
+}
+
+func (ArticleStatus) Type() protoreflect.EnumType {
+	return &file_api_blog_v1_blog_proto_enumTypes[0]
+}
+
+func (x ArticleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArticleStatus.Descriptor instead.
+func (ArticleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{0}
+}
+
 type Article struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	Like          int64                  `protobuf:"varint,4,opt,name=like,proto3" json:"like,omitempty"`
+	ViewCount     int64                  `protobuf:"varint,5,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`                     // bumped on every update, send it back in UpdateArticleRequest.version or If-Match
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // only set for articles in the trash
+	Status        ArticleStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=blog.v1.ArticleStatus" json:"status,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`            // only set while scheduled
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`     // only set while published
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`                                      // tag names, sorted
+	CategoryId    int64                  `protobuf:"varint,12,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`       // 0 when uncategorized
+	CommentCount  int64                  `protobuf:"varint,13,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"` // approved comments
+	AuthorId      int64                  `protobuf:"varint,14,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`             // 0 for articles written before accounts existed
+	Author        *UserSummary           `protobuf:"bytes,15,opt,name=author,proto3" json:"author,omitempty"`                                  // unset when author_id is 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -91,10 +168,90 @@ func (x *Article) GetLike() int64 {
 	return 0
 }
 
+func (x *Article) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *Article) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Article) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Article) GetStatus() ArticleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ArticleStatus_ARTICLE_STATUS_UNSPECIFIED
+}
+
+func (x *Article) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Article) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Article) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Article) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Article) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *Article) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Article) GetAuthor() *UserSummary {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
 type CreateArticleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // the title of string must be between 5 and 50 character
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // the title of string must be between 5 and 50 character
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// tag names, unknown ones are created
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	CategoryId    int64    `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // must exist, 0 for none
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -143,6 +300,20 @@ func (x *CreateArticleRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreateArticleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateArticleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
 type CreateArticleReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
@@ -188,10 +359,17 @@ func (x *CreateArticleReply) GetArticle() *Article {
 }
 
 type UpdateArticleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // the title of string must be between 5 and 50 character;
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// only checked when set; a field named in update_mask must be set
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // the title of string must be between 5 and 50 character;
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Version int64  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // expected current version, 0 skips the check
+	// fields to update, out of title, content, tags and category_id; empty means title and content
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// replaces every tag when "tags" is in update_mask, empty clears them
+	Tags          []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	CategoryId    int64    `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 0 clears the category
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -247,6 +425,34 @@ func (x *UpdateArticleRequest) GetContent() string {
 	return ""
 }
 
+func (x *UpdateArticleRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateArticleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
 type UpdateArticleReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
@@ -294,6 +500,7 @@ func (x *UpdateArticleReply) GetArticle() *Article {
 type DeleteArticleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Purge         bool                   `protobuf:"varint,2,opt,name=purge,proto3" json:"purge,omitempty"` // delete for good instead of moving to the trash
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -335,6 +542,13 @@ func (x *DeleteArticleRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteArticleRequest) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
 type DeleteArticleReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -460,7 +674,19 @@ func (x *GetArticleReply) GetArticle() *Article {
 }
 
 type ListArticleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // defaults to 20 when unset
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	// one of created_at, updated_at, like_count, optionally followed by " asc" or " desc"; defaults to "created_at desc"
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                      // matches articles whose title contains it
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // inclusive
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // exclusive
+	WithTotal     bool                   `protobuf:"varint,7,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`            // also count every article matching the filters
+	Status        ArticleStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=blog.v1.ArticleStatus" json:"status,omitempty"`        // defaults to published
+	Tag           string                 `protobuf:"bytes,9,opt,name=tag,proto3" json:"tag,omitempty"`                                          // only articles with this tag name
+	CategoryId    int64                  `protobuf:"varint,10,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`        // only articles in this category
+	AuthorId      int64                  `protobuf:"varint,11,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`              // only articles written by this user
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -495,9 +721,88 @@ func (*ListArticleRequest) Descriptor() ([]byte, []int) {
 	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{9}
 }
 
+func (x *ListArticleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListArticleRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListArticleRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListArticleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListArticleRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListArticleRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListArticleRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *ListArticleRequest) GetStatus() ArticleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ArticleStatus_ARTICLE_STATUS_UNSPECIFIED
+}
+
+func (x *ListArticleRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListArticleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListArticleRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
 type ListArticleReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Results       []*Article             `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalSize     int64                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // only set when with_total is requested
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -539,29 +844,44 @@ func (x *ListArticleReply) GetResults() []*Article {
 	return nil
 }
 
-type ArticleCastJsonRequest struct {
+func (x *ListArticleReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListArticleReply) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// SearchArticlesRequest matches published articles by title and content.
+type SearchArticlesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // the title of string must be between 5 and 50 character;
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 20 when unset
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArticleCastJsonRequest) Reset() {
-	*x = ArticleCastJsonRequest{}
+func (x *SearchArticlesRequest) Reset() {
+	*x = SearchArticlesRequest{}
 	mi := &file_api_blog_v1_blog_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArticleCastJsonRequest) String() string {
+func (x *SearchArticlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArticleCastJsonRequest) ProtoMessage() {}
+func (*SearchArticlesRequest) ProtoMessage() {}
 
-func (x *ArticleCastJsonRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchArticlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_blog_v1_blog_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,53 +893,57 @@ func (x *ArticleCastJsonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArticleCastJsonRequest.ProtoReflect.Descriptor instead.
-func (*ArticleCastJsonRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchArticlesRequest.ProtoReflect.Descriptor instead.
+func (*SearchArticlesRequest) Descriptor() ([]byte, []int) {
 	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ArticleCastJsonRequest) GetId() int64 {
+func (x *SearchArticlesRequest) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *ArticleCastJsonRequest) GetTitle() string {
+func (x *SearchArticlesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Title
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ArticleCastJsonRequest) GetContent() string {
+func (x *SearchArticlesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Content
+		return x.PageToken
 	}
 	return ""
 }
 
-type ArticleCastJsonReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SearchHit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Article *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	Score   float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // relevance, only comparable within one query
+	// HTML escaped text with matched terms wrapped in <em></em>
+	TitleSnippet   string `protobuf:"bytes,3,opt,name=title_snippet,json=titleSnippet,proto3" json:"title_snippet,omitempty"`
+	ContentSnippet string `protobuf:"bytes,4,opt,name=content_snippet,json=contentSnippet,proto3" json:"content_snippet,omitempty"` // a window of the content around the first match
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ArticleCastJsonReply) Reset() {
-	*x = ArticleCastJsonReply{}
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
 	mi := &file_api_blog_v1_blog_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArticleCastJsonReply) String() string {
+func (x *SearchHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArticleCastJsonReply) ProtoMessage() {}
+func (*SearchHit) ProtoMessage() {}
 
-func (x *ArticleCastJsonReply) ProtoReflect() protoreflect.Message {
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
 	mi := &file_api_blog_v1_blog_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,65 +955,2606 @@ func (x *ArticleCastJsonReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArticleCastJsonReply.ProtoReflect.Descriptor instead.
-func (*ArticleCastJsonReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
 	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ArticleCastJsonReply) GetJson() string {
+func (x *SearchHit) GetArticle() *Article {
 	if x != nil {
-		return x.Json
+		return x.Article
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitleSnippet() string {
+	if x != nil {
+		return x.TitleSnippet
 	}
 	return ""
 }
 
-var File_api_blog_v1_blog_proto protoreflect.FileDescriptor
+func (x *SearchHit) GetContentSnippet() string {
+	if x != nil {
+		return x.ContentSnippet
+	}
+	return ""
+}
 
-const file_api_blog_v1_blog_proto_rawDesc = "" +
-	"\n" +
-	"\x16api/blog/v1/blog.proto\x12\ablog.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"]\n" +
-	"\aArticle\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
-	"\x04like\x18\x04 \x01(\x03R\x04like\"]\n" +
-	"\x14CreateArticleRequest\x12\x1f\n" +
-	"\x05title\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x05\x182R\x05title\x12$\n" +
-	"\acontent\x18\x02 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x05\x18\xf4\x03R\acontent\"@\n" +
-	"\x12CreateArticleReply\x12*\n" +
-	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"v\n" +
-	"\x14UpdateArticleRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1f\n" +
-	"\x05title\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x05\x182R\x05title\x12$\n" +
-	"\acontent\x18\x03 \x01(\tB\n" +
-	"\xfaB\ar\x05\x10\x05\x18\xf4\x03R\acontent\"@\n" +
-	"\x12UpdateArticleReply\x12*\n" +
-	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"&\n" +
-	"\x14DeleteArticleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x14\n" +
-	"\x12DeleteArticleReply\"#\n" +
-	"\x11GetArticleRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"=\n" +
-	"\x0fGetArticleReply\x12*\n" +
-	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"\x14\n" +
-	"\x12ListArticleRequest\">\n" +
-	"\x10ListArticleReply\x12*\n" +
-	"\aresults\x18\x01 \x03(\v2\x10.blog.v1.ArticleR\aresults\"x\n" +
+type SearchArticlesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchHit           `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // most relevant first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArticlesReply) Reset() {
+	*x = SearchArticlesReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArticlesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArticlesReply) ProtoMessage() {}
+
+func (x *SearchArticlesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArticlesReply.ProtoReflect.Descriptor instead.
+func (*SearchArticlesReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchArticlesReply) GetResults() []*SearchHit {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchArticlesReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListDeletedArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 20 when unset
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedArticlesRequest) Reset() {
+	*x = ListDeletedArticlesRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedArticlesRequest) ProtoMessage() {}
+
+func (x *ListDeletedArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListDeletedArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedArticlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDeletedArticlesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// most recently deleted first; only the caller's own articles unless the
+	// role has read_unpublished
+	Results       []*Article `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedArticlesReply) Reset() {
+	*x = ListDeletedArticlesReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedArticlesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedArticlesReply) ProtoMessage() {}
+
+func (x *ListDeletedArticlesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedArticlesReply.ProtoReflect.Descriptor instead.
+func (*ListDeletedArticlesReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListDeletedArticlesReply) GetResults() []*Article {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListDeletedArticlesReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreArticleRequest) Reset() {
+	*x = RestoreArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreArticleRequest) ProtoMessage() {}
+
+func (x *RestoreArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreArticleRequest.ProtoReflect.Descriptor instead.
+func (*RestoreArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RestoreArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreArticleReply) Reset() {
+	*x = RestoreArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreArticleReply) ProtoMessage() {}
+
+func (x *RestoreArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreArticleReply.ProtoReflect.Descriptor instead.
+func (*RestoreArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreArticleReply) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type SubmitArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitArticleRequest) Reset() {
+	*x = SubmitArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitArticleRequest) ProtoMessage() {}
+
+func (x *SubmitArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitArticleRequest.ProtoReflect.Descriptor instead.
+func (*SubmitArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SubmitArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SubmitArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitArticleReply) Reset() {
+	*x = SubmitArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitArticleReply) ProtoMessage() {}
+
+func (x *SubmitArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitArticleReply.ProtoReflect.Descriptor instead.
+func (*SubmitArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SubmitArticleReply) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type ScheduleArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // must be in the future
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleArticleRequest) Reset() {
+	*x = ScheduleArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleArticleRequest) ProtoMessage() {}
+
+func (x *ScheduleArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleArticleRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScheduleArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleArticleRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+type ScheduleArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleArticleReply) Reset() {
+	*x = ScheduleArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleArticleReply) ProtoMessage() {}
+
+func (x *ScheduleArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleArticleReply.ProtoReflect.Descriptor instead.
+func (*ScheduleArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ScheduleArticleReply) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type PublishArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishArticleRequest) Reset() {
+	*x = PublishArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishArticleRequest) ProtoMessage() {}
+
+func (x *PublishArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishArticleRequest.ProtoReflect.Descriptor instead.
+func (*PublishArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PublishArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PublishArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishArticleReply) Reset() {
+	*x = PublishArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishArticleReply) ProtoMessage() {}
+
+func (x *PublishArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishArticleReply.ProtoReflect.Descriptor instead.
+func (*PublishArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PublishArticleReply) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+// UnpublishArticleRequest takes an article back to draft, cancelling a schedule.
+type UnpublishArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishArticleRequest) Reset() {
+	*x = UnpublishArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishArticleRequest) ProtoMessage() {}
+
+func (x *UnpublishArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishArticleRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnpublishArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnpublishArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishArticleReply) Reset() {
+	*x = UnpublishArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishArticleReply) ProtoMessage() {}
+
+func (x *UnpublishArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishArticleReply.ProtoReflect.Descriptor instead.
+func (*UnpublishArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnpublishArticleReply) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type ArchiveArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveArticleRequest) Reset() {
+	*x = ArchiveArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveArticleRequest) ProtoMessage() {}
+
+func (x *ArchiveArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveArticleRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ArchiveArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ArchiveArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveArticleReply) Reset() {
+	*x = ArchiveArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveArticleReply) ProtoMessage() {}
+
+func (x *ArchiveArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveArticleReply.ProtoReflect.Descriptor instead.
+func (*ArchiveArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ArchiveArticleReply) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+// ArticleRevision is an immutable snapshot of an article, taken on every write.
+type ArticleRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArticleId     int64                  `protobuf:"varint,1,opt,name=article_id,json=articleId,proto3" json:"article_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // the article version this write produced
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"` // left empty by ListArticleRevisions
+	Author        string                 `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleRevision) Reset() {
+	*x = ArticleRevision{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleRevision) ProtoMessage() {}
+
+func (x *ArticleRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleRevision.ProtoReflect.Descriptor instead.
+func (*ArticleRevision) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ArticleRevision) GetArticleId() int64 {
+	if x != nil {
+		return x.ArticleId
+	}
+	return 0
+}
+
+func (x *ArticleRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ArticleRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ArticleRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ArticleRevision) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ArticleRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListArticleRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 20 when unset
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticleRevisionsRequest) Reset() {
+	*x = ListArticleRevisionsRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticleRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticleRevisionsRequest) ProtoMessage() {}
+
+func (x *ListArticleRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticleRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListArticleRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListArticleRevisionsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListArticleRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListArticleRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListArticleRevisionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ArticleRevision     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticleRevisionsReply) Reset() {
+	*x = ListArticleRevisionsReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticleRevisionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticleRevisionsReply) ProtoMessage() {}
+
+func (x *ListArticleRevisionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticleRevisionsReply.ProtoReflect.Descriptor instead.
+func (*ListArticleRevisionsReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListArticleRevisionsReply) GetResults() []*ArticleRevision {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListArticleRevisionsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetArticleRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRevisionRequest) Reset() {
+	*x = GetArticleRevisionRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRevisionRequest) ProtoMessage() {}
+
+func (x *GetArticleRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetArticleRevisionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetArticleRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetArticleRevisionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *ArticleRevision       `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRevisionReply) Reset() {
+	*x = GetArticleRevisionReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRevisionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRevisionReply) ProtoMessage() {}
+
+func (x *GetArticleRevisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRevisionReply.ProtoReflect.Descriptor instead.
+func (*GetArticleRevisionReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetArticleRevisionReply) GetRevision() *ArticleRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type DiffArticleRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromVersion   int64                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int64                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffArticleRevisionsRequest) Reset() {
+	*x = DiffArticleRevisionsRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffArticleRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffArticleRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffArticleRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffArticleRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffArticleRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DiffArticleRevisionsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiffArticleRevisionsRequest) GetFromVersion() int64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffArticleRevisionsRequest) GetToVersion() int64 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+type DiffArticleRevisionsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line-based unified diff of the two snapshots, each rendered as the title,
+	// a blank line and the content; empty when they are identical
+	Diff          string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffArticleRevisionsReply) Reset() {
+	*x = DiffArticleRevisionsReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffArticleRevisionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffArticleRevisionsReply) ProtoMessage() {}
+
+func (x *DiffArticleRevisionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffArticleRevisionsReply.ProtoReflect.Descriptor instead.
+func (*DiffArticleRevisionsReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DiffArticleRevisionsReply) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type RollbackArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ToVersion     int64                  `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"` // revision whose title and content are restored
+	Version       int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                      // expected current version, 0 skips the check
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackArticleRequest) Reset() {
+	*x = RollbackArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackArticleRequest) ProtoMessage() {}
+
+func (x *RollbackArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackArticleRequest.ProtoReflect.Descriptor instead.
+func (*RollbackArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RollbackArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RollbackArticleRequest) GetToVersion() int64 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *RollbackArticleRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=Article,proto3" json:"Article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackArticleReply) Reset() {
+	*x = RollbackArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackArticleReply) ProtoMessage() {}
+
+func (x *RollbackArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackArticleReply.ProtoReflect.Descriptor instead.
+func (*RollbackArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RollbackArticleReply) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type LikeArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeArticleRequest) Reset() {
+	*x = LikeArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeArticleRequest) ProtoMessage() {}
+
+func (x *LikeArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeArticleRequest.ProtoReflect.Descriptor instead.
+func (*LikeArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LikeArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type LikeArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LikeCount     int64                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeArticleReply) Reset() {
+	*x = LikeArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeArticleReply) ProtoMessage() {}
+
+func (x *LikeArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeArticleReply.ProtoReflect.Descriptor instead.
+func (*LikeArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LikeArticleReply) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type UnlikeArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeArticleRequest) Reset() {
+	*x = UnlikeArticleRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeArticleRequest) ProtoMessage() {}
+
+func (x *UnlikeArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeArticleRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeArticleRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnlikeArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UnlikeArticleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LikeCount     int64                  `protobuf:"varint,1,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeArticleReply) Reset() {
+	*x = UnlikeArticleReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeArticleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeArticleReply) ProtoMessage() {}
+
+func (x *UnlikeArticleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeArticleReply.ProtoReflect.Descriptor instead.
+func (*UnlikeArticleReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnlikeArticleReply) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ArticleCount  int64                  `protobuf:"varint,3,opt,name=article_count,json=articleCount,proto3" json:"article_count,omitempty"` // published articles carrying the tag
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Tag) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetArticleCount() int64 {
+	if x != nil {
+		return x.ArticleCount
+	}
+	return 0
+}
+
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // no leading or trailing space
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTagReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagReply) Reset() {
+	*x = CreateTagReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagReply) ProtoMessage() {}
+
+func (x *CreateTagReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagReply.ProtoReflect.Descriptor instead.
+func (*CreateTagReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateTagReply) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateTagRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateTagReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagReply) Reset() {
+	*x = UpdateTagReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagReply) ProtoMessage() {}
+
+func (x *UpdateTagReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagReply.ProtoReflect.Descriptor instead.
+func (*UpdateTagReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateTagReply) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// DeleteTagRequest removes the tag from every article carrying it.
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteTagRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTagReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagReply) Reset() {
+	*x = DeleteTagReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagReply) ProtoMessage() {}
+
+func (x *DeleteTagReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagReply.ProtoReflect.Descriptor instead.
+func (*DeleteTagReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{47}
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{48}
+}
+
+type ListTagsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*Tag                 `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsReply) Reset() {
+	*x = ListTagsReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsReply) ProtoMessage() {}
+
+func (x *ListTagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsReply.ProtoReflect.Descriptor instead.
+func (*ListTagsReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListTagsReply) GetResults() []*Tag {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ArticleCount  int64                  `protobuf:"varint,4,opt,name=article_count,json=articleCount,proto3" json:"article_count,omitempty"` // published articles in the category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetArticleCount() int64 {
+	if x != nil {
+		return x.ArticleCount
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateCategoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryReply) Reset() {
+	*x = CreateCategoryReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryReply) ProtoMessage() {}
+
+func (x *CreateCategoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryReply.ProtoReflect.Descriptor instead.
+func (*CreateCategoryReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateCategoryReply) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateCategoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryReply) Reset() {
+	*x = UpdateCategoryReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryReply) ProtoMessage() {}
+
+func (x *UpdateCategoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryReply.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateCategoryReply) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// DeleteCategoryRequest leaves the articles of the category uncategorized.
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryReply) Reset() {
+	*x = DeleteCategoryReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryReply) ProtoMessage() {}
+
+func (x *DeleteCategoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryReply.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{56}
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{57}
+}
+
+type ListCategoriesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*Category            `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesReply) Reset() {
+	*x = ListCategoriesReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesReply) ProtoMessage() {}
+
+func (x *ListCategoriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesReply.ProtoReflect.Descriptor instead.
+func (*ListCategoriesReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListCategoriesReply) GetResults() []*Category {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ArticleCastJsonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"` // the title of string must be between 5 and 50 character;
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleCastJsonRequest) Reset() {
+	*x = ArticleCastJsonRequest{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleCastJsonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleCastJsonRequest) ProtoMessage() {}
+
+func (x *ArticleCastJsonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleCastJsonRequest.ProtoReflect.Descriptor instead.
+func (*ArticleCastJsonRequest) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ArticleCastJsonRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArticleCastJsonRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ArticleCastJsonRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ArticleCastJsonReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleCastJsonReply) Reset() {
+	*x = ArticleCastJsonReply{}
+	mi := &file_api_blog_v1_blog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleCastJsonReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleCastJsonReply) ProtoMessage() {}
+
+func (x *ArticleCastJsonReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_blog_v1_blog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleCastJsonReply.ProtoReflect.Descriptor instead.
+func (*ArticleCastJsonReply) Descriptor() ([]byte, []int) {
+	return file_api_blog_v1_blog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ArticleCastJsonReply) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+var File_api_blog_v1_blog_proto protoreflect.FileDescriptor
+
+const file_api_blog_v1_blog_proto_rawDesc = "" +
+	"\n" +
+	"\x16api/blog/v1/blog.proto\x12\ablog.v1\x1a\x16api/blog/v1/user.proto\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xa0\x04\n" +
+	"\aArticle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04like\x18\x04 \x01(\x03R\x04like\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x05 \x01(\x03R\tviewCount\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12.\n" +
+	"\x06status\x18\b \x01(\x0e2\x16.blog.v1.ArticleStatusR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\fpublished_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x1f\n" +
+	"\vcategory_id\x18\f \x01(\x03R\n" +
+	"categoryId\x12#\n" +
+	"\rcomment_count\x18\r \x01(\x03R\fcommentCount\x12\x1b\n" +
+	"\tauthor_id\x18\x0e \x01(\x03R\bauthorId\x12,\n" +
+	"\x06author\x18\x0f \x01(\v2\x14.blog.v1.UserSummaryR\x06author\"\xba\x01\n" +
+	"\x14CreateArticleRequest\x12\x1f\n" +
+	"\x05title\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x05\x182R\x05title\x12$\n" +
+	"\acontent\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x05\x18\xf4\x03R\acontent\x121\n" +
+	"\x04tags\x18\x03 \x03(\tB\x1d\xfaB\x1a\x92\x01\x17\x10\n" +
+	"\"\x13r\x11\x10\x01\x18 2\v^\\S(.*\\S)?$R\x04tags\x12(\n" +
+	"\vcategory_id\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"categoryId\"@\n" +
+	"\x12CreateArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"\xc7\x02\n" +
+	"\x14UpdateArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\"\n" +
+	"\x05title\x18\x02 \x01(\tB\f\xfaB\tr\a\x10\x05\x182\xd0\x01\x01R\x05title\x12'\n" +
+	"\acontent\x18\x03 \x01(\tB\r\xfaB\n" +
+	"r\b\x10\x05\x18\xf4\x03\xd0\x01\x01R\acontent\x12!\n" +
+	"\aversion\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aversion\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x121\n" +
+	"\x04tags\x18\a \x03(\tB\x1d\xfaB\x1a\x92\x01\x17\x10\n" +
+	"\"\x13r\x11\x10\x01\x18 2\v^\\S(.*\\S)?$R\x04tags\x12(\n" +
+	"\vcategory_id\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"categoryIdJ\x04\b\x06\x10\aR\x06author\"@\n" +
+	"\x12UpdateArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"<\n" +
+	"\x14DeleteArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05purge\x18\x02 \x01(\bR\x05purge\"\x14\n" +
+	"\x12DeleteArticleReply\"#\n" +
+	"\x11GetArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"=\n" +
+	"\x0fGetArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"\x9b\x04\n" +
+	"\x12ListArticleRequest\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12W\n" +
+	"\border_by\x18\x03 \x01(\tB<\xfaB9r725^((created_at|updated_at|like_count)( (asc|desc))?)?$R\aorderBy\x12\x1d\n" +
+	"\x05title\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x182R\x05title\x12?\n" +
+	"\rcreated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x1d\n" +
+	"\n" +
+	"with_total\x18\a \x01(\bR\twithTotal\x128\n" +
+	"\x06status\x18\b \x01(\x0e2\x16.blog.v1.ArticleStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12\x19\n" +
+	"\x03tag\x18\t \x01(\tB\a\xfaB\x04r\x02\x18 R\x03tag\x12(\n" +
+	"\vcategory_id\x18\n" +
+	" \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"categoryId\x12$\n" +
+	"\tauthor_id\x18\v \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bauthorId\"\x85\x01\n" +
+	"\x10ListArticleReply\x12*\n" +
+	"\aresults\x18\x01 \x03(\v2\x10.blog.v1.ArticleR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"\x7f\n" +
+	"\x15SearchArticlesRequest\x12\x1f\n" +
+	"\x05query\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x05query\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9b\x01\n" +
+	"\tSearchHit\x12*\n" +
+	"\aarticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aarticle\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12#\n" +
+	"\rtitle_snippet\x18\x03 \x01(\tR\ftitleSnippet\x12'\n" +
+	"\x0fcontent_snippet\x18\x04 \x01(\tR\x0econtentSnippet\"k\n" +
+	"\x13SearchArticlesReply\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.blog.v1.SearchHitR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"c\n" +
+	"\x1aListDeletedArticlesRequest\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"n\n" +
+	"\x18ListDeletedArticlesReply\x12*\n" +
+	"\aresults\x18\x01 \x03(\v2\x10.blog.v1.ArticleR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"0\n" +
+	"\x15RestoreArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"A\n" +
+	"\x13RestoreArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"/\n" +
+	"\x14SubmitArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"@\n" +
+	"\x12SubmitArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"v\n" +
+	"\x16ScheduleArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12C\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tpublishAt\"B\n" +
+	"\x14ScheduleArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"0\n" +
+	"\x15PublishArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"A\n" +
+	"\x13PublishArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"2\n" +
+	"\x17UnpublishArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"C\n" +
+	"\x15UnpublishArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"0\n" +
+	"\x15ArchiveArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"A\n" +
+	"\x13ArchiveArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"\xcd\x01\n" +
+	"\x0fArticleRevision\x12\x1d\n" +
+	"\n" +
+	"article_id\x18\x01 \x01(\x03R\tarticleId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
+	"\x06author\x18\x05 \x01(\tR\x06author\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"}\n" +
+	"\x1bListArticleRevisionsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
+	"\x19ListArticleRevisionsReply\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.blog.v1.ArticleRevisionR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"W\n" +
+	"\x19GetArticleRevisionRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12!\n" +
+	"\aversion\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\aversion\"O\n" +
+	"\x17GetArticleRevisionReply\x124\n" +
+	"\brevision\x18\x01 \x01(\v2\x18.blog.v1.ArticleRevisionR\brevision\"\x8a\x01\n" +
+	"\x1bDiffArticleRevisionsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12*\n" +
+	"\ffrom_version\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vfromVersion\x12&\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\ttoVersion\"/\n" +
+	"\x19DiffArticleRevisionsReply\x12\x12\n" +
+	"\x04diff\x18\x01 \x01(\tR\x04diff\"\x8a\x01\n" +
+	"\x16RollbackArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12&\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\ttoVersion\x12!\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aversionJ\x04\b\x04\x10\x05R\x06author\"B\n" +
+	"\x14RollbackArticleReply\x12*\n" +
+	"\aArticle\x18\x01 \x01(\v2\x10.blog.v1.ArticleR\aArticle\"<\n" +
+	"\x12LikeArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02idJ\x04\b\x02\x10\x03R\auser_id\"1\n" +
+	"\x10LikeArticleReply\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x01 \x01(\x03R\tlikeCount\">\n" +
+	"\x14UnlikeArticleRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02idJ\x04\b\x02\x10\x03R\auser_id\"3\n" +
+	"\x12UnlikeArticleReply\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x01 \x01(\x03R\tlikeCount\"N\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rarticle_count\x18\x03 \x01(\x03R\farticleCount\">\n" +
+	"\x10CreateTagRequest\x12*\n" +
+	"\x04name\x18\x01 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x18 2\v^\\S(.*\\S)?$R\x04name\"0\n" +
+	"\x0eCreateTagReply\x12\x1e\n" +
+	"\x03tag\x18\x01 \x01(\v2\f.blog.v1.TagR\x03tag\"W\n" +
+	"\x10UpdateTagRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12*\n" +
+	"\x04name\x18\x02 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x18 2\v^\\S(.*\\S)?$R\x04name\"0\n" +
+	"\x0eUpdateTagReply\x12\x1e\n" +
+	"\x03tag\x18\x01 \x01(\v2\f.blog.v1.TagR\x03tag\"+\n" +
+	"\x10DeleteTagRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x10\n" +
+	"\x0eDeleteTagReply\"\x11\n" +
+	"\x0fListTagsRequest\"7\n" +
+	"\rListTagsReply\x12&\n" +
+	"\aresults\x18\x01 \x03(\v2\f.blog.v1.TagR\aresults\"u\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rarticle_count\x18\x04 \x01(\x03R\farticleCount\"o\n" +
+	"\x15CreateCategoryRequest\x12*\n" +
+	"\x04name\x18\x01 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x1822\v^\\S(.*\\S)?$R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\vdescription\"D\n" +
+	"\x13CreateCategoryReply\x12-\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.blog.v1.CategoryR\bcategory\"\x88\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12*\n" +
+	"\x04name\x18\x02 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x1822\v^\\S(.*\\S)?$R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\vdescription\"D\n" +
+	"\x13UpdateCategoryReply\x12-\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.blog.v1.CategoryR\bcategory\"0\n" +
+	"\x15DeleteCategoryRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x15\n" +
+	"\x13DeleteCategoryReply\"\x17\n" +
+	"\x15ListCategoriesRequest\"B\n" +
+	"\x13ListCategoriesReply\x12+\n" +
+	"\aresults\x18\x01 \x03(\v2\x11.blog.v1.CategoryR\aresults\"x\n" +
 	"\x16ArticleCastJsonRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1f\n" +
 	"\x05title\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x05\x182R\x05title\x12$\n" +
 	"\acontent\x18\x03 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x05\x18\xf4\x03R\acontent\"*\n" +
 	"\x14ArticleCastJsonReply\x12\x12\n" +
-	"\x04json\x18\x01 \x01(\tR\x04json2\xf1\x04\n" +
+	"\x04json\x18\x01 \x01(\tR\x04json*\xc0\x01\n" +
+	"\rArticleStatus\x12\x1e\n" +
+	"\x1aARTICLE_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ARTICLE_STATUS_DRAFT\x10\x01\x12\x1c\n" +
+	"\x18ARTICLE_STATUS_IN_REVIEW\x10\x02\x12\x1c\n" +
+	"\x18ARTICLE_STATUS_SCHEDULED\x10\x03\x12\x1c\n" +
+	"\x18ARTICLE_STATUS_PUBLISHED\x10\x04\x12\x1b\n" +
+	"\x17ARTICLE_STATUS_ARCHIVED\x10\x052\xfb\x17\n" +
 	"\vBlogService\x12c\n" +
 	"\rCreateArticle\x12\x1d.blog.v1.CreateArticleRequest\x1a\x1b.blog.v1.CreateArticleReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/article\x12h\n" +
 	"\rUpdateArticle\x12\x1d.blog.v1.UpdateArticleRequest\x1a\x1b.blog.v1.UpdateArticleReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/article/{id}\x12e\n" +
 	"\rDeleteArticle\x12\x1d.blog.v1.DeleteArticleRequest\x1a\x1b.blog.v1.DeleteArticleReply\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/article/{id}\x12\\\n" +
 	"\n" +
 	"GetArticle\x12\x1a.blog.v1.GetArticleRequest\x1a\x18.blog.v1.GetArticleReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/article/{id}\x12Z\n" +
-	"\vListArticle\x12\x1b.blog.v1.ListArticleRequest\x1a\x19.blog.v1.ListArticleReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/article\x12r\n" +
+	"\vListArticle\x12\x1b.blog.v1.ListArticleRequest\x1a\x19.blog.v1.ListArticleReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/article\x12j\n" +
+	"\x0eSearchArticles\x12\x1e.blog.v1.SearchArticlesRequest\x1a\x1c.blog.v1.SearchArticlesReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/search/article\x12g\n" +
+	"\vLikeArticle\x12\x1b.blog.v1.LikeArticleRequest\x1a\x19.blog.v1.LikeArticleReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/article/{id}/like\x12j\n" +
+	"\rUnlikeArticle\x12\x1d.blog.v1.UnlikeArticleRequest\x1a\x1b.blog.v1.UnlikeArticleReply\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/article/{id}/like\x12x\n" +
+	"\x13ListDeletedArticles\x12#.blog.v1.ListDeletedArticlesRequest\x1a!.blog.v1.ListDeletedArticlesReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/trash/article\x12s\n" +
+	"\x0eRestoreArticle\x12\x1e.blog.v1.RestoreArticleRequest\x1a\x1c.blog.v1.RestoreArticleReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/article/{id}/restore\x12o\n" +
+	"\rSubmitArticle\x12\x1d.blog.v1.SubmitArticleRequest\x1a\x1b.blog.v1.SubmitArticleReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/article/{id}/submit\x12w\n" +
+	"\x0fScheduleArticle\x12\x1f.blog.v1.ScheduleArticleRequest\x1a\x1d.blog.v1.ScheduleArticleReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/article/{id}/schedule\x12s\n" +
+	"\x0ePublishArticle\x12\x1e.blog.v1.PublishArticleRequest\x1a\x1c.blog.v1.PublishArticleReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/article/{id}/publish\x12{\n" +
+	"\x10UnpublishArticle\x12 .blog.v1.UnpublishArticleRequest\x1a\x1e.blog.v1.UnpublishArticleReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/article/{id}/unpublish\x12s\n" +
+	"\x0eArchiveArticle\x12\x1e.blog.v1.ArchiveArticleRequest\x1a\x1c.blog.v1.ArchiveArticleReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/article/{id}/archive\x12\x84\x01\n" +
+	"\x14ListArticleRevisions\x12$.blog.v1.ListArticleRevisionsRequest\x1a\".blog.v1.ListArticleRevisionsReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/article/{id}/revisions\x12\x88\x01\n" +
+	"\x12GetArticleRevision\x12\".blog.v1.GetArticleRevisionRequest\x1a .blog.v1.GetArticleRevisionReply\",\x82\xd3\xe4\x93\x02&\x12$/v1/article/{id}/revisions/{version}\x12\x7f\n" +
+	"\x14DiffArticleRevisions\x12$.blog.v1.DiffArticleRevisionsRequest\x1a\".blog.v1.DiffArticleRevisionsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/article/{id}/diff\x12w\n" +
+	"\x0fRollbackArticle\x12\x1f.blog.v1.RollbackArticleRequest\x1a\x1d.blog.v1.RollbackArticleReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/article/{id}/rollback\x12S\n" +
+	"\tCreateTag\x12\x19.blog.v1.CreateTagRequest\x1a\x17.blog.v1.CreateTagReply\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/v1/tag\x12X\n" +
+	"\tUpdateTag\x12\x19.blog.v1.UpdateTagRequest\x1a\x17.blog.v1.UpdateTagReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/v1/tag/{id}\x12U\n" +
+	"\tDeleteTag\x12\x19.blog.v1.DeleteTagRequest\x1a\x17.blog.v1.DeleteTagReply\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/v1/tag/{id}\x12M\n" +
+	"\bListTags\x12\x18.blog.v1.ListTagsRequest\x1a\x16.blog.v1.ListTagsReply\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/v1/tag\x12g\n" +
+	"\x0eCreateCategory\x12\x1e.blog.v1.CreateCategoryRequest\x1a\x1c.blog.v1.CreateCategoryReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/category\x12l\n" +
+	"\x0eUpdateCategory\x12\x1e.blog.v1.UpdateCategoryRequest\x1a\x1c.blog.v1.UpdateCategoryReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/category/{id}\x12i\n" +
+	"\x0eDeleteCategory\x12\x1e.blog.v1.DeleteCategoryRequest\x1a\x1c.blog.v1.DeleteCategoryReply\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/category/{id}\x12d\n" +
+	"\x0eListCategories\x12\x1e.blog.v1.ListCategoriesRequest\x1a\x1c.blog.v1.ListCategoriesReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/category\x12r\n" +
 	"\x0fArticleCastJson\x12\x1f.blog.v1.ArticleCastJsonRequest\x1a\x1d.blog.v1.ArticleCastJsonReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/article/castjsonB\x17Z\x15agdemo/api/blog/v1;v1b\x06proto3"
 
 var (
@@ -704,44 +3569,170 @@ func file_api_blog_v1_blog_proto_rawDescGZIP() []byte {
 	return file_api_blog_v1_blog_proto_rawDescData
 }
 
-var file_api_blog_v1_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_blog_v1_blog_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_blog_v1_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_api_blog_v1_blog_proto_goTypes = []any{
-	(*Article)(nil),                // 0: blog.v1.Article
-	(*CreateArticleRequest)(nil),   // 1: blog.v1.CreateArticleRequest
-	(*CreateArticleReply)(nil),     // 2: blog.v1.CreateArticleReply
-	(*UpdateArticleRequest)(nil),   // 3: blog.v1.UpdateArticleRequest
-	(*UpdateArticleReply)(nil),     // 4: blog.v1.UpdateArticleReply
-	(*DeleteArticleRequest)(nil),   // 5: blog.v1.DeleteArticleRequest
-	(*DeleteArticleReply)(nil),     // 6: blog.v1.DeleteArticleReply
-	(*GetArticleRequest)(nil),      // 7: blog.v1.GetArticleRequest
-	(*GetArticleReply)(nil),        // 8: blog.v1.GetArticleReply
-	(*ListArticleRequest)(nil),     // 9: blog.v1.ListArticleRequest
-	(*ListArticleReply)(nil),       // 10: blog.v1.ListArticleReply
-	(*ArticleCastJsonRequest)(nil), // 11: blog.v1.ArticleCastJsonRequest
-	(*ArticleCastJsonReply)(nil),   // 12: blog.v1.ArticleCastJsonReply
+	(ArticleStatus)(0),                  // 0: blog.v1.ArticleStatus
+	(*Article)(nil),                     // 1: blog.v1.Article
+	(*CreateArticleRequest)(nil),        // 2: blog.v1.CreateArticleRequest
+	(*CreateArticleReply)(nil),          // 3: blog.v1.CreateArticleReply
+	(*UpdateArticleRequest)(nil),        // 4: blog.v1.UpdateArticleRequest
+	(*UpdateArticleReply)(nil),          // 5: blog.v1.UpdateArticleReply
+	(*DeleteArticleRequest)(nil),        // 6: blog.v1.DeleteArticleRequest
+	(*DeleteArticleReply)(nil),          // 7: blog.v1.DeleteArticleReply
+	(*GetArticleRequest)(nil),           // 8: blog.v1.GetArticleRequest
+	(*GetArticleReply)(nil),             // 9: blog.v1.GetArticleReply
+	(*ListArticleRequest)(nil),          // 10: blog.v1.ListArticleRequest
+	(*ListArticleReply)(nil),            // 11: blog.v1.ListArticleReply
+	(*SearchArticlesRequest)(nil),       // 12: blog.v1.SearchArticlesRequest
+	(*SearchHit)(nil),                   // 13: blog.v1.SearchHit
+	(*SearchArticlesReply)(nil),         // 14: blog.v1.SearchArticlesReply
+	(*ListDeletedArticlesRequest)(nil),  // 15: blog.v1.ListDeletedArticlesRequest
+	(*ListDeletedArticlesReply)(nil),    // 16: blog.v1.ListDeletedArticlesReply
+	(*RestoreArticleRequest)(nil),       // 17: blog.v1.RestoreArticleRequest
+	(*RestoreArticleReply)(nil),         // 18: blog.v1.RestoreArticleReply
+	(*SubmitArticleRequest)(nil),        // 19: blog.v1.SubmitArticleRequest
+	(*SubmitArticleReply)(nil),          // 20: blog.v1.SubmitArticleReply
+	(*ScheduleArticleRequest)(nil),      // 21: blog.v1.ScheduleArticleRequest
+	(*ScheduleArticleReply)(nil),        // 22: blog.v1.ScheduleArticleReply
+	(*PublishArticleRequest)(nil),       // 23: blog.v1.PublishArticleRequest
+	(*PublishArticleReply)(nil),         // 24: blog.v1.PublishArticleReply
+	(*UnpublishArticleRequest)(nil),     // 25: blog.v1.UnpublishArticleRequest
+	(*UnpublishArticleReply)(nil),       // 26: blog.v1.UnpublishArticleReply
+	(*ArchiveArticleRequest)(nil),       // 27: blog.v1.ArchiveArticleRequest
+	(*ArchiveArticleReply)(nil),         // 28: blog.v1.ArchiveArticleReply
+	(*ArticleRevision)(nil),             // 29: blog.v1.ArticleRevision
+	(*ListArticleRevisionsRequest)(nil), // 30: blog.v1.ListArticleRevisionsRequest
+	(*ListArticleRevisionsReply)(nil),   // 31: blog.v1.ListArticleRevisionsReply
+	(*GetArticleRevisionRequest)(nil),   // 32: blog.v1.GetArticleRevisionRequest
+	(*GetArticleRevisionReply)(nil),     // 33: blog.v1.GetArticleRevisionReply
+	(*DiffArticleRevisionsRequest)(nil), // 34: blog.v1.DiffArticleRevisionsRequest
+	(*DiffArticleRevisionsReply)(nil),   // 35: blog.v1.DiffArticleRevisionsReply
+	(*RollbackArticleRequest)(nil),      // 36: blog.v1.RollbackArticleRequest
+	(*RollbackArticleReply)(nil),        // 37: blog.v1.RollbackArticleReply
+	(*LikeArticleRequest)(nil),          // 38: blog.v1.LikeArticleRequest
+	(*LikeArticleReply)(nil),            // 39: blog.v1.LikeArticleReply
+	(*UnlikeArticleRequest)(nil),        // 40: blog.v1.UnlikeArticleRequest
+	(*UnlikeArticleReply)(nil),          // 41: blog.v1.UnlikeArticleReply
+	(*Tag)(nil),                         // 42: blog.v1.Tag
+	(*CreateTagRequest)(nil),            // 43: blog.v1.CreateTagRequest
+	(*CreateTagReply)(nil),              // 44: blog.v1.CreateTagReply
+	(*UpdateTagRequest)(nil),            // 45: blog.v1.UpdateTagRequest
+	(*UpdateTagReply)(nil),              // 46: blog.v1.UpdateTagReply
+	(*DeleteTagRequest)(nil),            // 47: blog.v1.DeleteTagRequest
+	(*DeleteTagReply)(nil),              // 48: blog.v1.DeleteTagReply
+	(*ListTagsRequest)(nil),             // 49: blog.v1.ListTagsRequest
+	(*ListTagsReply)(nil),               // 50: blog.v1.ListTagsReply
+	(*Category)(nil),                    // 51: blog.v1.Category
+	(*CreateCategoryRequest)(nil),       // 52: blog.v1.CreateCategoryRequest
+	(*CreateCategoryReply)(nil),         // 53: blog.v1.CreateCategoryReply
+	(*UpdateCategoryRequest)(nil),       // 54: blog.v1.UpdateCategoryRequest
+	(*UpdateCategoryReply)(nil),         // 55: blog.v1.UpdateCategoryReply
+	(*DeleteCategoryRequest)(nil),       // 56: blog.v1.DeleteCategoryRequest
+	(*DeleteCategoryReply)(nil),         // 57: blog.v1.DeleteCategoryReply
+	(*ListCategoriesRequest)(nil),       // 58: blog.v1.ListCategoriesRequest
+	(*ListCategoriesReply)(nil),         // 59: blog.v1.ListCategoriesReply
+	(*ArticleCastJsonRequest)(nil),      // 60: blog.v1.ArticleCastJsonRequest
+	(*ArticleCastJsonReply)(nil),        // 61: blog.v1.ArticleCastJsonReply
+	(*timestamppb.Timestamp)(nil),       // 62: google.protobuf.Timestamp
+	(*UserSummary)(nil),                 // 63: blog.v1.UserSummary
+	(*fieldmaskpb.FieldMask)(nil),       // 64: google.protobuf.FieldMask
 }
 var file_api_blog_v1_blog_proto_depIdxs = []int32{
-	0,  // 0: blog.v1.CreateArticleReply.Article:type_name -> blog.v1.Article
-	0,  // 1: blog.v1.UpdateArticleReply.Article:type_name -> blog.v1.Article
-	0,  // 2: blog.v1.GetArticleReply.Article:type_name -> blog.v1.Article
-	0,  // 3: blog.v1.ListArticleReply.results:type_name -> blog.v1.Article
-	1,  // 4: blog.v1.BlogService.CreateArticle:input_type -> blog.v1.CreateArticleRequest
-	3,  // 5: blog.v1.BlogService.UpdateArticle:input_type -> blog.v1.UpdateArticleRequest
-	5,  // 6: blog.v1.BlogService.DeleteArticle:input_type -> blog.v1.DeleteArticleRequest
-	7,  // 7: blog.v1.BlogService.GetArticle:input_type -> blog.v1.GetArticleRequest
-	9,  // 8: blog.v1.BlogService.ListArticle:input_type -> blog.v1.ListArticleRequest
-	11, // 9: blog.v1.BlogService.ArticleCastJson:input_type -> blog.v1.ArticleCastJsonRequest
-	2,  // 10: blog.v1.BlogService.CreateArticle:output_type -> blog.v1.CreateArticleReply
-	4,  // 11: blog.v1.BlogService.UpdateArticle:output_type -> blog.v1.UpdateArticleReply
-	6,  // 12: blog.v1.BlogService.DeleteArticle:output_type -> blog.v1.DeleteArticleReply
-	8,  // 13: blog.v1.BlogService.GetArticle:output_type -> blog.v1.GetArticleReply
-	10, // 14: blog.v1.BlogService.ListArticle:output_type -> blog.v1.ListArticleReply
-	12, // 15: blog.v1.BlogService.ArticleCastJson:output_type -> blog.v1.ArticleCastJsonReply
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	62, // 0: blog.v1.Article.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: blog.v1.Article.status:type_name -> blog.v1.ArticleStatus
+	62, // 2: blog.v1.Article.publish_at:type_name -> google.protobuf.Timestamp
+	62, // 3: blog.v1.Article.published_at:type_name -> google.protobuf.Timestamp
+	63, // 4: blog.v1.Article.author:type_name -> blog.v1.UserSummary
+	1,  // 5: blog.v1.CreateArticleReply.Article:type_name -> blog.v1.Article
+	64, // 6: blog.v1.UpdateArticleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 7: blog.v1.UpdateArticleReply.Article:type_name -> blog.v1.Article
+	1,  // 8: blog.v1.GetArticleReply.Article:type_name -> blog.v1.Article
+	62, // 9: blog.v1.ListArticleRequest.created_after:type_name -> google.protobuf.Timestamp
+	62, // 10: blog.v1.ListArticleRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,  // 11: blog.v1.ListArticleRequest.status:type_name -> blog.v1.ArticleStatus
+	1,  // 12: blog.v1.ListArticleReply.results:type_name -> blog.v1.Article
+	1,  // 13: blog.v1.SearchHit.article:type_name -> blog.v1.Article
+	13, // 14: blog.v1.SearchArticlesReply.results:type_name -> blog.v1.SearchHit
+	1,  // 15: blog.v1.ListDeletedArticlesReply.results:type_name -> blog.v1.Article
+	1,  // 16: blog.v1.RestoreArticleReply.Article:type_name -> blog.v1.Article
+	1,  // 17: blog.v1.SubmitArticleReply.Article:type_name -> blog.v1.Article
+	62, // 18: blog.v1.ScheduleArticleRequest.publish_at:type_name -> google.protobuf.Timestamp
+	1,  // 19: blog.v1.ScheduleArticleReply.Article:type_name -> blog.v1.Article
+	1,  // 20: blog.v1.PublishArticleReply.Article:type_name -> blog.v1.Article
+	1,  // 21: blog.v1.UnpublishArticleReply.Article:type_name -> blog.v1.Article
+	1,  // 22: blog.v1.ArchiveArticleReply.Article:type_name -> blog.v1.Article
+	62, // 23: blog.v1.ArticleRevision.created_at:type_name -> google.protobuf.Timestamp
+	29, // 24: blog.v1.ListArticleRevisionsReply.results:type_name -> blog.v1.ArticleRevision
+	29, // 25: blog.v1.GetArticleRevisionReply.revision:type_name -> blog.v1.ArticleRevision
+	1,  // 26: blog.v1.RollbackArticleReply.Article:type_name -> blog.v1.Article
+	42, // 27: blog.v1.CreateTagReply.tag:type_name -> blog.v1.Tag
+	42, // 28: blog.v1.UpdateTagReply.tag:type_name -> blog.v1.Tag
+	42, // 29: blog.v1.ListTagsReply.results:type_name -> blog.v1.Tag
+	51, // 30: blog.v1.CreateCategoryReply.category:type_name -> blog.v1.Category
+	51, // 31: blog.v1.UpdateCategoryReply.category:type_name -> blog.v1.Category
+	51, // 32: blog.v1.ListCategoriesReply.results:type_name -> blog.v1.Category
+	2,  // 33: blog.v1.BlogService.CreateArticle:input_type -> blog.v1.CreateArticleRequest
+	4,  // 34: blog.v1.BlogService.UpdateArticle:input_type -> blog.v1.UpdateArticleRequest
+	6,  // 35: blog.v1.BlogService.DeleteArticle:input_type -> blog.v1.DeleteArticleRequest
+	8,  // 36: blog.v1.BlogService.GetArticle:input_type -> blog.v1.GetArticleRequest
+	10, // 37: blog.v1.BlogService.ListArticle:input_type -> blog.v1.ListArticleRequest
+	12, // 38: blog.v1.BlogService.SearchArticles:input_type -> blog.v1.SearchArticlesRequest
+	38, // 39: blog.v1.BlogService.LikeArticle:input_type -> blog.v1.LikeArticleRequest
+	40, // 40: blog.v1.BlogService.UnlikeArticle:input_type -> blog.v1.UnlikeArticleRequest
+	15, // 41: blog.v1.BlogService.ListDeletedArticles:input_type -> blog.v1.ListDeletedArticlesRequest
+	17, // 42: blog.v1.BlogService.RestoreArticle:input_type -> blog.v1.RestoreArticleRequest
+	19, // 43: blog.v1.BlogService.SubmitArticle:input_type -> blog.v1.SubmitArticleRequest
+	21, // 44: blog.v1.BlogService.ScheduleArticle:input_type -> blog.v1.ScheduleArticleRequest
+	23, // 45: blog.v1.BlogService.PublishArticle:input_type -> blog.v1.PublishArticleRequest
+	25, // 46: blog.v1.BlogService.UnpublishArticle:input_type -> blog.v1.UnpublishArticleRequest
+	27, // 47: blog.v1.BlogService.ArchiveArticle:input_type -> blog.v1.ArchiveArticleRequest
+	30, // 48: blog.v1.BlogService.ListArticleRevisions:input_type -> blog.v1.ListArticleRevisionsRequest
+	32, // 49: blog.v1.BlogService.GetArticleRevision:input_type -> blog.v1.GetArticleRevisionRequest
+	34, // 50: blog.v1.BlogService.DiffArticleRevisions:input_type -> blog.v1.DiffArticleRevisionsRequest
+	36, // 51: blog.v1.BlogService.RollbackArticle:input_type -> blog.v1.RollbackArticleRequest
+	43, // 52: blog.v1.BlogService.CreateTag:input_type -> blog.v1.CreateTagRequest
+	45, // 53: blog.v1.BlogService.UpdateTag:input_type -> blog.v1.UpdateTagRequest
+	47, // 54: blog.v1.BlogService.DeleteTag:input_type -> blog.v1.DeleteTagRequest
+	49, // 55: blog.v1.BlogService.ListTags:input_type -> blog.v1.ListTagsRequest
+	52, // 56: blog.v1.BlogService.CreateCategory:input_type -> blog.v1.CreateCategoryRequest
+	54, // 57: blog.v1.BlogService.UpdateCategory:input_type -> blog.v1.UpdateCategoryRequest
+	56, // 58: blog.v1.BlogService.DeleteCategory:input_type -> blog.v1.DeleteCategoryRequest
+	58, // 59: blog.v1.BlogService.ListCategories:input_type -> blog.v1.ListCategoriesRequest
+	60, // 60: blog.v1.BlogService.ArticleCastJson:input_type -> blog.v1.ArticleCastJsonRequest
+	3,  // 61: blog.v1.BlogService.CreateArticle:output_type -> blog.v1.CreateArticleReply
+	5,  // 62: blog.v1.BlogService.UpdateArticle:output_type -> blog.v1.UpdateArticleReply
+	7,  // 63: blog.v1.BlogService.DeleteArticle:output_type -> blog.v1.DeleteArticleReply
+	9,  // 64: blog.v1.BlogService.GetArticle:output_type -> blog.v1.GetArticleReply
+	11, // 65: blog.v1.BlogService.ListArticle:output_type -> blog.v1.ListArticleReply
+	14, // 66: blog.v1.BlogService.SearchArticles:output_type -> blog.v1.SearchArticlesReply
+	39, // 67: blog.v1.BlogService.LikeArticle:output_type -> blog.v1.LikeArticleReply
+	41, // 68: blog.v1.BlogService.UnlikeArticle:output_type -> blog.v1.UnlikeArticleReply
+	16, // 69: blog.v1.BlogService.ListDeletedArticles:output_type -> blog.v1.ListDeletedArticlesReply
+	18, // 70: blog.v1.BlogService.RestoreArticle:output_type -> blog.v1.RestoreArticleReply
+	20, // 71: blog.v1.BlogService.SubmitArticle:output_type -> blog.v1.SubmitArticleReply
+	22, // 72: blog.v1.BlogService.ScheduleArticle:output_type -> blog.v1.ScheduleArticleReply
+	24, // 73: blog.v1.BlogService.PublishArticle:output_type -> blog.v1.PublishArticleReply
+	26, // 74: blog.v1.BlogService.UnpublishArticle:output_type -> blog.v1.UnpublishArticleReply
+	28, // 75: blog.v1.BlogService.ArchiveArticle:output_type -> blog.v1.ArchiveArticleReply
+	31, // 76: blog.v1.BlogService.ListArticleRevisions:output_type -> blog.v1.ListArticleRevisionsReply
+	33, // 77: blog.v1.BlogService.GetArticleRevision:output_type -> blog.v1.GetArticleRevisionReply
+	35, // 78: blog.v1.BlogService.DiffArticleRevisions:output_type -> blog.v1.DiffArticleRevisionsReply
+	37, // 79: blog.v1.BlogService.RollbackArticle:output_type -> blog.v1.RollbackArticleReply
+	44, // 80: blog.v1.BlogService.CreateTag:output_type -> blog.v1.CreateTagReply
+	46, // 81: blog.v1.BlogService.UpdateTag:output_type -> blog.v1.UpdateTagReply
+	48, // 82: blog.v1.BlogService.DeleteTag:output_type -> blog.v1.DeleteTagReply
+	50, // 83: blog.v1.BlogService.ListTags:output_type -> blog.v1.ListTagsReply
+	53, // 84: blog.v1.BlogService.CreateCategory:output_type -> blog.v1.CreateCategoryReply
+	55, // 85: blog.v1.BlogService.UpdateCategory:output_type -> blog.v1.UpdateCategoryReply
+	57, // 86: blog.v1.BlogService.DeleteCategory:output_type -> blog.v1.DeleteCategoryReply
+	59, // 87: blog.v1.BlogService.ListCategories:output_type -> blog.v1.ListCategoriesReply
+	61, // 88: blog.v1.BlogService.ArticleCastJson:output_type -> blog.v1.ArticleCastJsonReply
+	61, // [61:89] is the sub-list for method output_type
+	33, // [33:61] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_api_blog_v1_blog_proto_init() }
@@ -749,18 +3740,20 @@ func file_api_blog_v1_blog_proto_init() {
 	if File_api_blog_v1_blog_proto != nil {
 		return
 	}
+	file_api_blog_v1_user_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_blog_v1_blog_proto_rawDesc), len(file_api_blog_v1_blog_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      1,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_blog_v1_blog_proto_goTypes,
 		DependencyIndexes: file_api_blog_v1_blog_proto_depIdxs,
+		EnumInfos:         file_api_blog_v1_blog_proto_enumTypes,
 		MessageInfos:      file_api_blog_v1_blog_proto_msgTypes,
 	}.Build()
 	File_api_blog_v1_blog_proto = out.File
@@ -64,6 +64,134 @@ func (m *Article) validate(all bool) error {
 
 	// no validation rules for Like
 
+	// no validation rules for ViewCount
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "PublishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "PublishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "PublishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CategoryId
+
+	// no validation rules for CommentCount
+
+	// no validation rules for AuthorId
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ArticleMultiError(errors)
 	}
@@ -185,6 +313,55 @@ func (m *CreateArticleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetTags()) > 10 {
+		err := CreateArticleRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 32 {
+			err := CreateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_CreateArticleRequest_Tags_Pattern.MatchString(item) {
+			err := CreateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value does not match regex pattern \"^\\\\S(.*\\\\S)?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCategoryId() < 0 {
+		err := CreateArticleRequestValidationError{
+			field:  "CategoryId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateArticleRequestMultiError(errors)
 	}
@@ -265,6 +442,8 @@ var _ interface {
 	ErrorName() string
 } = CreateArticleRequestValidationError{}
 
+var _CreateArticleRequest_Tags_Pattern = regexp.MustCompile("^\\S(.*\\S)?$")
+
 // Validate checks the field values on CreateArticleReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -429,10 +608,40 @@ func (m *UpdateArticleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetTitle()); l < 5 || l > 50 {
+	if m.GetTitle() != "" {
+
+		if l := utf8.RuneCountInString(m.GetTitle()); l < 5 || l > 50 {
+			err := UpdateArticleRequestValidationError{
+				field:  "Title",
+				reason: "value length must be between 5 and 50 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetContent() != "" {
+
+		if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 500 {
+			err := UpdateArticleRequestValidationError{
+				field:  "Content",
+				reason: "value length must be between 5 and 500 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetVersion() < 0 {
 		err := UpdateArticleRequestValidationError{
-			field:  "Title",
-			reason: "value length must be between 5 and 50 runes, inclusive",
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -440,10 +649,77 @@ func (m *UpdateArticleRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 5 || l > 500 {
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateArticleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateArticleRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateArticleRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetTags()) > 10 {
 		err := UpdateArticleRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 5 and 500 runes, inclusive",
+			field:  "Tags",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 32 {
+			err := UpdateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateArticleRequest_Tags_Pattern.MatchString(item) {
+			err := UpdateArticleRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value does not match regex pattern \"^\\\\S(.*\\\\S)?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetCategoryId() < 0 {
+		err := UpdateArticleRequestValidationError{
+			field:  "CategoryId",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -531,6 +807,8 @@ var _ interface {
 	ErrorName() string
 } = UpdateArticleRequestValidationError{}
 
+var _UpdateArticleRequest_Tags_Pattern = regexp.MustCompile("^\\S(.*\\S)?$")
+
 // Validate checks the field values on UpdateArticleReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -686,6 +964,8 @@ func (m *DeleteArticleRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Purge
+
 	if len(errors) > 0 {
 		return DeleteArticleRequestMultiError(errors)
 	}
@@ -1123,71 +1403,210 @@ func (m *ListArticleRequest) validate(all bool) error {
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return ListArticleRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// ListArticleRequestMultiError is an error wrapping multiple validation errors
-// returned by ListArticleRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListArticleRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ListArticleRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListArticleRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ListArticleRequestMultiError) AllErrors() []error { return m }
-
-// ListArticleRequestValidationError is the validation error returned by
-// ListArticleRequest.Validate if the designated constraints aren't met.
-type ListArticleRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ListArticleRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ListArticleRequestValidationError) Reason() string { return e.reason }
 
-// Cause function returns cause value.
-func (e ListArticleRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ListArticleRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ListArticleRequestValidationError) ErrorName() string {
-	return "ListArticleRequestValidationError"
-}
+	// no validation rules for PageToken
 
-// Error satisfies the builtin error interface
-func (e ListArticleRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	if !_ListArticleRequest_OrderBy_Pattern.MatchString(m.GetOrderBy()) {
+		err := ListArticleRequestValidationError{
+			field:  "OrderBy",
+			reason: "value does not match regex pattern \"^((created_at|updated_at|like_count)( (asc|desc))?)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
+	if utf8.RuneCountInString(m.GetTitle()) > 50 {
+		err := ListArticleRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return fmt.Sprintf(
-		"invalid %sListArticleRequest.%s: %s%s",
-		key,
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListArticleRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListArticleRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListArticleRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListArticleRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListArticleRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListArticleRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for WithTotal
+
+	if _, ok := ArticleStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListArticleRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTag()) > 32 {
+		err := ListArticleRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCategoryId() < 0 {
+		err := ListArticleRequestValidationError{
+			field:  "CategoryId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAuthorId() < 0 {
+		err := ListArticleRequestValidationError{
+			field:  "AuthorId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArticleRequestMultiError is an error wrapping multiple validation errors
+// returned by ListArticleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArticleRequestMultiError) AllErrors() []error { return m }
+
+// ListArticleRequestValidationError is the validation error returned by
+// ListArticleRequest.Validate if the designated constraints aren't met.
+type ListArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArticleRequestValidationError) ErrorName() string {
+	return "ListArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArticleRequest.%s: %s%s",
+		key,
 		e.field,
 		e.reason,
 		cause)
@@ -1203,6 +1622,8 @@ var _ interface {
 	ErrorName() string
 } = ListArticleRequestValidationError{}
 
+var _ListArticleRequest_OrderBy_Pattern = regexp.MustCompile("^((created_at|updated_at|like_count)( (asc|desc))?)?$")
+
 // Validate checks the field values on ListArticleReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1259,6 +1680,10 @@ func (m *ListArticleReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
 	if len(errors) > 0 {
 		return ListArticleReplyMultiError(errors)
 	}
@@ -1337,6 +1762,5902 @@ var _ interface {
 	ErrorName() string
 } = ListArticleReplyValidationError{}
 
+// Validate checks the field values on SearchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticlesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticlesRequestMultiError, or nil if none found.
+func (m *SearchArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 100 {
+		err := SearchArticlesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 50 {
+		err := SearchArticlesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticlesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchArticlesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticlesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticlesRequestMultiError) AllErrors() []error { return m }
+
+// SearchArticlesRequestValidationError is the validation error returned by
+// SearchArticlesRequest.Validate if the designated constraints aren't met.
+type SearchArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticlesRequestValidationError) ErrorName() string {
+	return "SearchArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticlesRequestValidationError{}
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
+
+	// no validation rules for TitleSnippet
+
+	// no validation rules for ContentSnippet
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on SearchArticlesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchArticlesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchArticlesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchArticlesReplyMultiError, or nil if none found.
+func (m *SearchArticlesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchArticlesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchArticlesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchArticlesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchArticlesReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchArticlesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchArticlesReplyMultiError is an error wrapping multiple validation
+// errors returned by SearchArticlesReply.ValidateAll() if the designated
+// constraints aren't met.
+type SearchArticlesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchArticlesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchArticlesReplyMultiError) AllErrors() []error { return m }
+
+// SearchArticlesReplyValidationError is the validation error returned by
+// SearchArticlesReply.Validate if the designated constraints aren't met.
+type SearchArticlesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchArticlesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchArticlesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchArticlesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchArticlesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchArticlesReplyValidationError) ErrorName() string {
+	return "SearchArticlesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchArticlesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchArticlesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchArticlesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchArticlesReplyValidationError{}
+
+// Validate checks the field values on ListDeletedArticlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedArticlesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedArticlesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedArticlesRequestMultiError, or nil if none found.
+func (m *ListDeletedArticlesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedArticlesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListDeletedArticlesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListDeletedArticlesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedArticlesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDeletedArticlesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeletedArticlesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedArticlesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedArticlesRequestMultiError) AllErrors() []error { return m }
+
+// ListDeletedArticlesRequestValidationError is the validation error returned
+// by ListDeletedArticlesRequest.Validate if the designated constraints aren't met.
+type ListDeletedArticlesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedArticlesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedArticlesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedArticlesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedArticlesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedArticlesRequestValidationError) ErrorName() string {
+	return "ListDeletedArticlesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedArticlesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedArticlesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedArticlesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedArticlesRequestValidationError{}
+
+// Validate checks the field values on ListDeletedArticlesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedArticlesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedArticlesReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedArticlesReplyMultiError, or nil if none found.
+func (m *ListDeletedArticlesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedArticlesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedArticlesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedArticlesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedArticlesReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDeletedArticlesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedArticlesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedArticlesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedArticlesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedArticlesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedArticlesReplyMultiError) AllErrors() []error { return m }
+
+// ListDeletedArticlesReplyValidationError is the validation error returned by
+// ListDeletedArticlesReply.Validate if the designated constraints aren't met.
+type ListDeletedArticlesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedArticlesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedArticlesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedArticlesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedArticlesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedArticlesReplyValidationError) ErrorName() string {
+	return "ListDeletedArticlesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedArticlesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedArticlesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedArticlesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedArticlesReplyValidationError{}
+
+// Validate checks the field values on RestoreArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreArticleRequestMultiError, or nil if none found.
+func (m *RestoreArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RestoreArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreArticleRequestMultiError) AllErrors() []error { return m }
+
+// RestoreArticleRequestValidationError is the validation error returned by
+// RestoreArticleRequest.Validate if the designated constraints aren't met.
+type RestoreArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreArticleRequestValidationError) ErrorName() string {
+	return "RestoreArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreArticleRequestValidationError{}
+
+// Validate checks the field values on RestoreArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreArticleReplyMultiError, or nil if none found.
+func (m *RestoreArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreArticleReplyValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RestoreArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreArticleReplyMultiError is an error wrapping multiple validation
+// errors returned by RestoreArticleReply.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreArticleReplyMultiError) AllErrors() []error { return m }
+
+// RestoreArticleReplyValidationError is the validation error returned by
+// RestoreArticleReply.Validate if the designated constraints aren't met.
+type RestoreArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreArticleReplyValidationError) ErrorName() string {
+	return "RestoreArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreArticleReplyValidationError{}
+
+// Validate checks the field values on SubmitArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitArticleRequestMultiError, or nil if none found.
+func (m *SubmitArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := SubmitArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitArticleRequestMultiError) AllErrors() []error { return m }
+
+// SubmitArticleRequestValidationError is the validation error returned by
+// SubmitArticleRequest.Validate if the designated constraints aren't met.
+type SubmitArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitArticleRequestValidationError) ErrorName() string {
+	return "SubmitArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitArticleRequestValidationError{}
+
+// Validate checks the field values on SubmitArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitArticleReplyMultiError, or nil if none found.
+func (m *SubmitArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitArticleReplyValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitArticleReplyMultiError is an error wrapping multiple validation errors
+// returned by SubmitArticleReply.ValidateAll() if the designated constraints
+// aren't met.
+type SubmitArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitArticleReplyMultiError) AllErrors() []error { return m }
+
+// SubmitArticleReplyValidationError is the validation error returned by
+// SubmitArticleReply.Validate if the designated constraints aren't met.
+type SubmitArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitArticleReplyValidationError) ErrorName() string {
+	return "SubmitArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitArticleReplyValidationError{}
+
+// Validate checks the field values on ScheduleArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleArticleRequestMultiError, or nil if none found.
+func (m *ScheduleArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ScheduleArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPublishAt() == nil {
+		err := ScheduleArticleRequestValidationError{
+			field:  "PublishAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ScheduleArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by ScheduleArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleArticleRequestMultiError) AllErrors() []error { return m }
+
+// ScheduleArticleRequestValidationError is the validation error returned by
+// ScheduleArticleRequest.Validate if the designated constraints aren't met.
+type ScheduleArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleArticleRequestValidationError) ErrorName() string {
+	return "ScheduleArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleArticleRequestValidationError{}
+
+// Validate checks the field values on ScheduleArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleArticleReplyMultiError, or nil if none found.
+func (m *ScheduleArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleArticleReplyValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduleArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleArticleReplyMultiError is an error wrapping multiple validation
+// errors returned by ScheduleArticleReply.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleArticleReplyMultiError) AllErrors() []error { return m }
+
+// ScheduleArticleReplyValidationError is the validation error returned by
+// ScheduleArticleReply.Validate if the designated constraints aren't met.
+type ScheduleArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleArticleReplyValidationError) ErrorName() string {
+	return "ScheduleArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleArticleReplyValidationError{}
+
+// Validate checks the field values on PublishArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishArticleRequestMultiError, or nil if none found.
+func (m *PublishArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := PublishArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PublishArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by PublishArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PublishArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishArticleRequestMultiError) AllErrors() []error { return m }
+
+// PublishArticleRequestValidationError is the validation error returned by
+// PublishArticleRequest.Validate if the designated constraints aren't met.
+type PublishArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishArticleRequestValidationError) ErrorName() string {
+	return "PublishArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishArticleRequestValidationError{}
+
+// Validate checks the field values on PublishArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishArticleReplyMultiError, or nil if none found.
+func (m *PublishArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublishArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublishArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublishArticleReplyValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PublishArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishArticleReplyMultiError is an error wrapping multiple validation
+// errors returned by PublishArticleReply.ValidateAll() if the designated
+// constraints aren't met.
+type PublishArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishArticleReplyMultiError) AllErrors() []error { return m }
+
+// PublishArticleReplyValidationError is the validation error returned by
+// PublishArticleReply.Validate if the designated constraints aren't met.
+type PublishArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishArticleReplyValidationError) ErrorName() string {
+	return "PublishArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishArticleReplyValidationError{}
+
+// Validate checks the field values on UnpublishArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpublishArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpublishArticleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpublishArticleRequestMultiError, or nil if none found.
+func (m *UnpublishArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpublishArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UnpublishArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnpublishArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpublishArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpublishArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpublishArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpublishArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpublishArticleRequestMultiError) AllErrors() []error { return m }
+
+// UnpublishArticleRequestValidationError is the validation error returned by
+// UnpublishArticleRequest.Validate if the designated constraints aren't met.
+type UnpublishArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishArticleRequestValidationError) ErrorName() string {
+	return "UnpublishArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishArticleRequestValidationError{}
+
+// Validate checks the field values on UnpublishArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpublishArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpublishArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpublishArticleReplyMultiError, or nil if none found.
+func (m *UnpublishArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpublishArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnpublishArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnpublishArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnpublishArticleReplyValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnpublishArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpublishArticleReplyMultiError is an error wrapping multiple validation
+// errors returned by UnpublishArticleReply.ValidateAll() if the designated
+// constraints aren't met.
+type UnpublishArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpublishArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpublishArticleReplyMultiError) AllErrors() []error { return m }
+
+// UnpublishArticleReplyValidationError is the validation error returned by
+// UnpublishArticleReply.Validate if the designated constraints aren't met.
+type UnpublishArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishArticleReplyValidationError) ErrorName() string {
+	return "UnpublishArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishArticleReplyValidationError{}
+
+// Validate checks the field values on ArchiveArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveArticleRequestMultiError, or nil if none found.
+func (m *ArchiveArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ArchiveArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ArchiveArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by ArchiveArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ArchiveArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveArticleRequestMultiError) AllErrors() []error { return m }
+
+// ArchiveArticleRequestValidationError is the validation error returned by
+// ArchiveArticleRequest.Validate if the designated constraints aren't met.
+type ArchiveArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveArticleRequestValidationError) ErrorName() string {
+	return "ArchiveArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveArticleRequestValidationError{}
+
+// Validate checks the field values on ArchiveArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveArticleReplyMultiError, or nil if none found.
+func (m *ArchiveArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArchiveArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArchiveArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArchiveArticleReplyValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArchiveArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveArticleReplyMultiError is an error wrapping multiple validation
+// errors returned by ArchiveArticleReply.ValidateAll() if the designated
+// constraints aren't met.
+type ArchiveArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveArticleReplyMultiError) AllErrors() []error { return m }
+
+// ArchiveArticleReplyValidationError is the validation error returned by
+// ArchiveArticleReply.Validate if the designated constraints aren't met.
+type ArchiveArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveArticleReplyValidationError) ErrorName() string {
+	return "ArchiveArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveArticleReplyValidationError{}
+
+// Validate checks the field values on ArticleRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArticleRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArticleRevision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArticleRevisionMultiError, or nil if none found.
+func (m *ArticleRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArticleRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ArticleId
+
+	// no validation rules for Version
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Author
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArticleRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArticleRevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArticleRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArticleRevisionMultiError is an error wrapping multiple validation errors
+// returned by ArticleRevision.ValidateAll() if the designated constraints
+// aren't met.
+type ArticleRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArticleRevisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArticleRevisionMultiError) AllErrors() []error { return m }
+
+// ArticleRevisionValidationError is the validation error returned by
+// ArticleRevision.Validate if the designated constraints aren't met.
+type ArticleRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArticleRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArticleRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArticleRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArticleRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArticleRevisionValidationError) ErrorName() string { return "ArticleRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArticleRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArticleRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArticleRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArticleRevisionValidationError{}
+
+// Validate checks the field values on ListArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArticleRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArticleRevisionsRequestMultiError, or nil if none found.
+func (m *ListArticleRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArticleRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := ListArticleRevisionsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListArticleRevisionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListArticleRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArticleRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListArticleRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListArticleRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArticleRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArticleRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListArticleRevisionsRequestValidationError is the validation error returned
+// by ListArticleRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type ListArticleRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArticleRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArticleRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArticleRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArticleRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArticleRevisionsRequestValidationError) ErrorName() string {
+	return "ListArticleRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArticleRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArticleRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArticleRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArticleRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListArticleRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListArticleRevisionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListArticleRevisionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListArticleRevisionsReplyMultiError, or nil if none found.
+func (m *ListArticleRevisionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListArticleRevisionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListArticleRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListArticleRevisionsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListArticleRevisionsReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListArticleRevisionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListArticleRevisionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListArticleRevisionsReply.ValidateAll() if the
+// designated constraints aren't met.
+type ListArticleRevisionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListArticleRevisionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListArticleRevisionsReplyMultiError) AllErrors() []error { return m }
+
+// ListArticleRevisionsReplyValidationError is the validation error returned by
+// ListArticleRevisionsReply.Validate if the designated constraints aren't met.
+type ListArticleRevisionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListArticleRevisionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListArticleRevisionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListArticleRevisionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListArticleRevisionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListArticleRevisionsReplyValidationError) ErrorName() string {
+	return "ListArticleRevisionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListArticleRevisionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListArticleRevisionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListArticleRevisionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListArticleRevisionsReplyValidationError{}
+
+// Validate checks the field values on GetArticleRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleRevisionRequestMultiError, or nil if none found.
+func (m *GetArticleRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetArticleRevisionRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := GetArticleRevisionRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetArticleRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArticleRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetArticleRevisionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetArticleRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetArticleRevisionRequestValidationError is the validation error returned by
+// GetArticleRevisionRequest.Validate if the designated constraints aren't met.
+type GetArticleRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleRevisionRequestValidationError) ErrorName() string {
+	return "GetArticleRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleRevisionRequestValidationError{}
+
+// Validate checks the field values on GetArticleRevisionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetArticleRevisionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetArticleRevisionReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetArticleRevisionReplyMultiError, or nil if none found.
+func (m *GetArticleRevisionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetArticleRevisionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRevision()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetArticleRevisionReplyValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetArticleRevisionReplyValidationError{
+					field:  "Revision",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevision()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetArticleRevisionReplyValidationError{
+				field:  "Revision",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetArticleRevisionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetArticleRevisionReplyMultiError is an error wrapping multiple validation
+// errors returned by GetArticleRevisionReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetArticleRevisionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetArticleRevisionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetArticleRevisionReplyMultiError) AllErrors() []error { return m }
+
+// GetArticleRevisionReplyValidationError is the validation error returned by
+// GetArticleRevisionReply.Validate if the designated constraints aren't met.
+type GetArticleRevisionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetArticleRevisionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetArticleRevisionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetArticleRevisionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetArticleRevisionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetArticleRevisionReplyValidationError) ErrorName() string {
+	return "GetArticleRevisionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetArticleRevisionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetArticleRevisionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetArticleRevisionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetArticleRevisionReplyValidationError{}
+
+// Validate checks the field values on DiffArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffArticleRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffArticleRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffArticleRevisionsRequestMultiError, or nil if none found.
+func (m *DiffArticleRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffArticleRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DiffArticleRevisionsRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFromVersion() <= 0 {
+		err := DiffArticleRevisionsRequestValidationError{
+			field:  "FromVersion",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToVersion() <= 0 {
+		err := DiffArticleRevisionsRequestValidationError{
+			field:  "ToVersion",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiffArticleRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffArticleRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by DiffArticleRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DiffArticleRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffArticleRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffArticleRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffArticleRevisionsRequestValidationError is the validation error returned
+// by DiffArticleRevisionsRequest.Validate if the designated constraints
+// aren't met.
+type DiffArticleRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffArticleRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffArticleRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffArticleRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffArticleRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffArticleRevisionsRequestValidationError) ErrorName() string {
+	return "DiffArticleRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffArticleRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffArticleRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffArticleRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffArticleRevisionsRequestValidationError{}
+
+// Validate checks the field values on DiffArticleRevisionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffArticleRevisionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffArticleRevisionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffArticleRevisionsReplyMultiError, or nil if none found.
+func (m *DiffArticleRevisionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffArticleRevisionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Diff
+
+	if len(errors) > 0 {
+		return DiffArticleRevisionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffArticleRevisionsReplyMultiError is an error wrapping multiple validation
+// errors returned by DiffArticleRevisionsReply.ValidateAll() if the
+// designated constraints aren't met.
+type DiffArticleRevisionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffArticleRevisionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffArticleRevisionsReplyMultiError) AllErrors() []error { return m }
+
+// DiffArticleRevisionsReplyValidationError is the validation error returned by
+// DiffArticleRevisionsReply.Validate if the designated constraints aren't met.
+type DiffArticleRevisionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffArticleRevisionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffArticleRevisionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffArticleRevisionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffArticleRevisionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffArticleRevisionsReplyValidationError) ErrorName() string {
+	return "DiffArticleRevisionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffArticleRevisionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffArticleRevisionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffArticleRevisionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffArticleRevisionsReplyValidationError{}
+
+// Validate checks the field values on RollbackArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackArticleRequestMultiError, or nil if none found.
+func (m *RollbackArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RollbackArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToVersion() <= 0 {
+		err := RollbackArticleRequestValidationError{
+			field:  "ToVersion",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 0 {
+		err := RollbackArticleRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackArticleRequestMultiError) AllErrors() []error { return m }
+
+// RollbackArticleRequestValidationError is the validation error returned by
+// RollbackArticleRequest.Validate if the designated constraints aren't met.
+type RollbackArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackArticleRequestValidationError) ErrorName() string {
+	return "RollbackArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackArticleRequestValidationError{}
+
+// Validate checks the field values on RollbackArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackArticleReplyMultiError, or nil if none found.
+func (m *RollbackArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetArticle()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RollbackArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RollbackArticleReplyValidationError{
+					field:  "Article",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetArticle()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackArticleReplyValidationError{
+				field:  "Article",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RollbackArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackArticleReplyMultiError is an error wrapping multiple validation
+// errors returned by RollbackArticleReply.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackArticleReplyMultiError) AllErrors() []error { return m }
+
+// RollbackArticleReplyValidationError is the validation error returned by
+// RollbackArticleReply.Validate if the designated constraints aren't met.
+type RollbackArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackArticleReplyValidationError) ErrorName() string {
+	return "RollbackArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackArticleReplyValidationError{}
+
+// Validate checks the field values on LikeArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LikeArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LikeArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LikeArticleRequestMultiError, or nil if none found.
+func (m *LikeArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LikeArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := LikeArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LikeArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LikeArticleRequestMultiError is an error wrapping multiple validation errors
+// returned by LikeArticleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LikeArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LikeArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LikeArticleRequestMultiError) AllErrors() []error { return m }
+
+// LikeArticleRequestValidationError is the validation error returned by
+// LikeArticleRequest.Validate if the designated constraints aren't met.
+type LikeArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LikeArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LikeArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LikeArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LikeArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LikeArticleRequestValidationError) ErrorName() string {
+	return "LikeArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LikeArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLikeArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LikeArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LikeArticleRequestValidationError{}
+
+// Validate checks the field values on LikeArticleReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LikeArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LikeArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LikeArticleReplyMultiError, or nil if none found.
+func (m *LikeArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LikeArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LikeCount
+
+	if len(errors) > 0 {
+		return LikeArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LikeArticleReplyMultiError is an error wrapping multiple validation errors
+// returned by LikeArticleReply.ValidateAll() if the designated constraints
+// aren't met.
+type LikeArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LikeArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LikeArticleReplyMultiError) AllErrors() []error { return m }
+
+// LikeArticleReplyValidationError is the validation error returned by
+// LikeArticleReply.Validate if the designated constraints aren't met.
+type LikeArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LikeArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LikeArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LikeArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LikeArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LikeArticleReplyValidationError) ErrorName() string { return "LikeArticleReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LikeArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLikeArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LikeArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LikeArticleReplyValidationError{}
+
+// Validate checks the field values on UnlikeArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlikeArticleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlikeArticleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlikeArticleRequestMultiError, or nil if none found.
+func (m *UnlikeArticleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlikeArticleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UnlikeArticleRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnlikeArticleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlikeArticleRequestMultiError is an error wrapping multiple validation
+// errors returned by UnlikeArticleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnlikeArticleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlikeArticleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlikeArticleRequestMultiError) AllErrors() []error { return m }
+
+// UnlikeArticleRequestValidationError is the validation error returned by
+// UnlikeArticleRequest.Validate if the designated constraints aren't met.
+type UnlikeArticleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlikeArticleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlikeArticleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlikeArticleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlikeArticleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlikeArticleRequestValidationError) ErrorName() string {
+	return "UnlikeArticleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlikeArticleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlikeArticleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlikeArticleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlikeArticleRequestValidationError{}
+
+// Validate checks the field values on UnlikeArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlikeArticleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlikeArticleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlikeArticleReplyMultiError, or nil if none found.
+func (m *UnlikeArticleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlikeArticleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LikeCount
+
+	if len(errors) > 0 {
+		return UnlikeArticleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlikeArticleReplyMultiError is an error wrapping multiple validation errors
+// returned by UnlikeArticleReply.ValidateAll() if the designated constraints
+// aren't met.
+type UnlikeArticleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlikeArticleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlikeArticleReplyMultiError) AllErrors() []error { return m }
+
+// UnlikeArticleReplyValidationError is the validation error returned by
+// UnlikeArticleReply.Validate if the designated constraints aren't met.
+type UnlikeArticleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlikeArticleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlikeArticleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlikeArticleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlikeArticleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlikeArticleReplyValidationError) ErrorName() string {
+	return "UnlikeArticleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlikeArticleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlikeArticleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlikeArticleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlikeArticleReplyValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ArticleCount
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on CreateTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTagRequestMultiError, or nil if none found.
+func (m *CreateTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := CreateTagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateTagRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateTagRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^\\\\S(.*\\\\S)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagRequestMultiError) AllErrors() []error { return m }
+
+// CreateTagRequestValidationError is the validation error returned by
+// CreateTagRequest.Validate if the designated constraints aren't met.
+type CreateTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagRequestValidationError) ErrorName() string { return "CreateTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagRequestValidationError{}
+
+var _CreateTagRequest_Name_Pattern = regexp.MustCompile("^\\S(.*\\S)?$")
+
+// Validate checks the field values on CreateTagReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateTagReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateTagReplyMultiError,
+// or nil if none found.
+func (m *CreateTagReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTagReplyValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTagReplyValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTagReplyValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTagReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateTagReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTagReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagReplyMultiError) AllErrors() []error { return m }
+
+// CreateTagReplyValidationError is the validation error returned by
+// CreateTagReply.Validate if the designated constraints aren't met.
+type CreateTagReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagReplyValidationError) ErrorName() string { return "CreateTagReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateTagReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagReplyValidationError{}
+
+// Validate checks the field values on UpdateTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagRequestMultiError, or nil if none found.
+func (m *UpdateTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateTagRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 32 {
+		err := UpdateTagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateTagRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := UpdateTagRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^\\\\S(.*\\\\S)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTagRequestValidationError is the validation error returned by
+// UpdateTagRequest.Validate if the designated constraints aren't met.
+type UpdateTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagRequestValidationError) ErrorName() string { return "UpdateTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagRequestValidationError{}
+
+var _UpdateTagRequest_Name_Pattern = regexp.MustCompile("^\\S(.*\\S)?$")
+
+// Validate checks the field values on UpdateTagReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateTagReplyMultiError,
+// or nil if none found.
+func (m *UpdateTagReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTagReplyValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTagReplyValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTagReplyValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateTagReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTagReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagReplyMultiError) AllErrors() []error { return m }
+
+// UpdateTagReplyValidationError is the validation error returned by
+// UpdateTagReply.Validate if the designated constraints aren't met.
+type UpdateTagReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagReplyValidationError) ErrorName() string { return "UpdateTagReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateTagReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagReplyValidationError{}
+
+// Validate checks the field values on DeleteTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagRequestMultiError, or nil if none found.
+func (m *DeleteTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteTagRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTagRequestValidationError is the validation error returned by
+// DeleteTagRequest.Validate if the designated constraints aren't met.
+type DeleteTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagRequestValidationError) ErrorName() string { return "DeleteTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagRequestValidationError{}
+
+// Validate checks the field values on DeleteTagReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteTagReplyMultiError,
+// or nil if none found.
+func (m *DeleteTagReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTagReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteTagReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTagReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagReplyMultiError) AllErrors() []error { return m }
+
+// DeleteTagReplyValidationError is the validation error returned by
+// DeleteTagReply.Validate if the designated constraints aren't met.
+type DeleteTagReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagReplyValidationError) ErrorName() string { return "DeleteTagReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteTagReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagReplyValidationError{}
+
+// Validate checks the field values on ListTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsRequestMultiError, or nil if none found.
+func (m *ListTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTagsRequestValidationError is the validation error returned by
+// ListTagsRequest.Validate if the designated constraints aren't met.
+type ListTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestValidationError) ErrorName() string { return "ListTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestValidationError{}
+
+// Validate checks the field values on ListTagsReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListTagsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListTagsReplyMultiError, or
+// nil if none found.
+func (m *ListTagsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTagsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTagsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsReplyMultiError) AllErrors() []error { return m }
+
+// ListTagsReplyValidationError is the validation error returned by
+// ListTagsReply.Validate if the designated constraints aren't met.
+type ListTagsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsReplyValidationError) ErrorName() string { return "ListTagsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsReplyValidationError{}
+
+// Validate checks the field values on Category with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Category) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Category with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryMultiError, or nil
+// if none found.
+func (m *Category) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Category) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for ArticleCount
+
+	if len(errors) > 0 {
+		return CategoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryMultiError is an error wrapping multiple validation errors returned
+// by Category.ValidateAll() if the designated constraints aren't met.
+type CategoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryMultiError) AllErrors() []error { return m }
+
+// CategoryValidationError is the validation error returned by
+// Category.Validate if the designated constraints aren't met.
+type CategoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryValidationError) ErrorName() string { return "CategoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryValidationError{}
+
+// Validate checks the field values on CreateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCategoryRequestMultiError, or nil if none found.
+func (m *CreateCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := CreateCategoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateCategoryRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateCategoryRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^\\\\S(.*\\\\S)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 200 {
+		err := CreateCategoryRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCategoryRequestMultiError) AllErrors() []error { return m }
+
+// CreateCategoryRequestValidationError is the validation error returned by
+// CreateCategoryRequest.Validate if the designated constraints aren't met.
+type CreateCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCategoryRequestValidationError) ErrorName() string {
+	return "CreateCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCategoryRequestValidationError{}
+
+var _CreateCategoryRequest_Name_Pattern = regexp.MustCompile("^\\S(.*\\S)?$")
+
+// Validate checks the field values on CreateCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCategoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCategoryReplyMultiError, or nil if none found.
+func (m *CreateCategoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCategoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCategoryReplyValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCategoryReplyValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCategoryReplyValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCategoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCategoryReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateCategoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCategoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCategoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCategoryReplyMultiError) AllErrors() []error { return m }
+
+// CreateCategoryReplyValidationError is the validation error returned by
+// CreateCategoryReply.Validate if the designated constraints aren't met.
+type CreateCategoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCategoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCategoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCategoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCategoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCategoryReplyValidationError) ErrorName() string {
+	return "CreateCategoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCategoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCategoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCategoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCategoryReplyValidationError{}
+
+// Validate checks the field values on UpdateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCategoryRequestMultiError, or nil if none found.
+func (m *UpdateCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateCategoryRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := UpdateCategoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateCategoryRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := UpdateCategoryRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^\\\\S(.*\\\\S)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 200 {
+		err := UpdateCategoryRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCategoryRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCategoryRequestValidationError is the validation error returned by
+// UpdateCategoryRequest.Validate if the designated constraints aren't met.
+type UpdateCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCategoryRequestValidationError) ErrorName() string {
+	return "UpdateCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCategoryRequestValidationError{}
+
+var _UpdateCategoryRequest_Name_Pattern = regexp.MustCompile("^\\S(.*\\S)?$")
+
+// Validate checks the field values on UpdateCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCategoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCategoryReplyMultiError, or nil if none found.
+func (m *UpdateCategoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCategoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCategoryReplyValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCategoryReplyValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCategoryReplyValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCategoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCategoryReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateCategoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCategoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCategoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCategoryReplyMultiError) AllErrors() []error { return m }
+
+// UpdateCategoryReplyValidationError is the validation error returned by
+// UpdateCategoryReply.Validate if the designated constraints aren't met.
+type UpdateCategoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCategoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCategoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCategoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCategoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCategoryReplyValidationError) ErrorName() string {
+	return "UpdateCategoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCategoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCategoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCategoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCategoryReplyValidationError{}
+
+// Validate checks the field values on DeleteCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryRequestMultiError, or nil if none found.
+func (m *DeleteCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteCategoryRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCategoryRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCategoryRequestValidationError is the validation error returned by
+// DeleteCategoryRequest.Validate if the designated constraints aren't met.
+type DeleteCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryRequestValidationError) ErrorName() string {
+	return "DeleteCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryRequestValidationError{}
+
+// Validate checks the field values on DeleteCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryReplyMultiError, or nil if none found.
+func (m *DeleteCategoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCategoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteCategoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCategoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCategoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCategoryReplyMultiError) AllErrors() []error { return m }
+
+// DeleteCategoryReplyValidationError is the validation error returned by
+// DeleteCategoryReply.Validate if the designated constraints aren't met.
+type DeleteCategoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryReplyValidationError) ErrorName() string {
+	return "DeleteCategoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryReplyValidationError{}
+
+// Validate checks the field values on ListCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCategoriesRequestMultiError, or nil if none found.
+func (m *ListCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCategoriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCategoriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCategoriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// ListCategoriesRequestValidationError is the validation error returned by
+// ListCategoriesRequest.Validate if the designated constraints aren't met.
+type ListCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoriesRequestValidationError) ErrorName() string {
+	return "ListCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoriesRequestValidationError{}
+
+// Validate checks the field values on ListCategoriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCategoriesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCategoriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCategoriesReplyMultiError, or nil if none found.
+func (m *ListCategoriesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCategoriesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCategoriesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCategoriesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCategoriesReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCategoriesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCategoriesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListCategoriesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListCategoriesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCategoriesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCategoriesReplyMultiError) AllErrors() []error { return m }
+
+// ListCategoriesReplyValidationError is the validation error returned by
+// ListCategoriesReply.Validate if the designated constraints aren't met.
+type ListCategoriesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCategoriesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCategoriesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCategoriesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCategoriesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCategoriesReplyValidationError) ErrorName() string {
+	return "ListCategoriesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCategoriesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCategoriesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCategoriesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCategoriesReplyValidationError{}
+
 // Validate checks the field values on ArticleCastJsonRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
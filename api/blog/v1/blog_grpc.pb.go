@@ -19,12 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BlogService_CreateArticle_FullMethodName   = "/blog.v1.BlogService/CreateArticle"
-	BlogService_UpdateArticle_FullMethodName   = "/blog.v1.BlogService/UpdateArticle"
-	BlogService_DeleteArticle_FullMethodName   = "/blog.v1.BlogService/DeleteArticle"
-	BlogService_GetArticle_FullMethodName      = "/blog.v1.BlogService/GetArticle"
-	BlogService_ListArticle_FullMethodName     = "/blog.v1.BlogService/ListArticle"
-	BlogService_ArticleCastJson_FullMethodName = "/blog.v1.BlogService/ArticleCastJson"
+	BlogService_CreateArticle_FullMethodName        = "/blog.v1.BlogService/CreateArticle"
+	BlogService_UpdateArticle_FullMethodName        = "/blog.v1.BlogService/UpdateArticle"
+	BlogService_DeleteArticle_FullMethodName        = "/blog.v1.BlogService/DeleteArticle"
+	BlogService_GetArticle_FullMethodName           = "/blog.v1.BlogService/GetArticle"
+	BlogService_ListArticle_FullMethodName          = "/blog.v1.BlogService/ListArticle"
+	BlogService_SearchArticles_FullMethodName       = "/blog.v1.BlogService/SearchArticles"
+	BlogService_LikeArticle_FullMethodName          = "/blog.v1.BlogService/LikeArticle"
+	BlogService_UnlikeArticle_FullMethodName        = "/blog.v1.BlogService/UnlikeArticle"
+	BlogService_ListDeletedArticles_FullMethodName  = "/blog.v1.BlogService/ListDeletedArticles"
+	BlogService_RestoreArticle_FullMethodName       = "/blog.v1.BlogService/RestoreArticle"
+	BlogService_SubmitArticle_FullMethodName        = "/blog.v1.BlogService/SubmitArticle"
+	BlogService_ScheduleArticle_FullMethodName      = "/blog.v1.BlogService/ScheduleArticle"
+	BlogService_PublishArticle_FullMethodName       = "/blog.v1.BlogService/PublishArticle"
+	BlogService_UnpublishArticle_FullMethodName     = "/blog.v1.BlogService/UnpublishArticle"
+	BlogService_ArchiveArticle_FullMethodName       = "/blog.v1.BlogService/ArchiveArticle"
+	BlogService_ListArticleRevisions_FullMethodName = "/blog.v1.BlogService/ListArticleRevisions"
+	BlogService_GetArticleRevision_FullMethodName   = "/blog.v1.BlogService/GetArticleRevision"
+	BlogService_DiffArticleRevisions_FullMethodName = "/blog.v1.BlogService/DiffArticleRevisions"
+	BlogService_RollbackArticle_FullMethodName      = "/blog.v1.BlogService/RollbackArticle"
+	BlogService_CreateTag_FullMethodName            = "/blog.v1.BlogService/CreateTag"
+	BlogService_UpdateTag_FullMethodName            = "/blog.v1.BlogService/UpdateTag"
+	BlogService_DeleteTag_FullMethodName            = "/blog.v1.BlogService/DeleteTag"
+	BlogService_ListTags_FullMethodName             = "/blog.v1.BlogService/ListTags"
+	BlogService_CreateCategory_FullMethodName       = "/blog.v1.BlogService/CreateCategory"
+	BlogService_UpdateCategory_FullMethodName       = "/blog.v1.BlogService/UpdateCategory"
+	BlogService_DeleteCategory_FullMethodName       = "/blog.v1.BlogService/DeleteCategory"
+	BlogService_ListCategories_FullMethodName       = "/blog.v1.BlogService/ListCategories"
+	BlogService_ArticleCastJson_FullMethodName      = "/blog.v1.BlogService/ArticleCastJson"
 )
 
 // BlogServiceClient is the client API for BlogService service.
@@ -36,6 +58,29 @@ type BlogServiceClient interface {
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*DeleteArticleReply, error)
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleReply, error)
 	ListArticle(ctx context.Context, in *ListArticleRequest, opts ...grpc.CallOption) (*ListArticleReply, error)
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesReply, error)
+	LikeArticle(ctx context.Context, in *LikeArticleRequest, opts ...grpc.CallOption) (*LikeArticleReply, error)
+	UnlikeArticle(ctx context.Context, in *UnlikeArticleRequest, opts ...grpc.CallOption) (*UnlikeArticleReply, error)
+	ListDeletedArticles(ctx context.Context, in *ListDeletedArticlesRequest, opts ...grpc.CallOption) (*ListDeletedArticlesReply, error)
+	RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleReply, error)
+	// workflow: draft -> in_review -> scheduled -> published -> archived, see ArticleStatus
+	SubmitArticle(ctx context.Context, in *SubmitArticleRequest, opts ...grpc.CallOption) (*SubmitArticleReply, error)
+	ScheduleArticle(ctx context.Context, in *ScheduleArticleRequest, opts ...grpc.CallOption) (*ScheduleArticleReply, error)
+	PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...grpc.CallOption) (*PublishArticleReply, error)
+	UnpublishArticle(ctx context.Context, in *UnpublishArticleRequest, opts ...grpc.CallOption) (*UnpublishArticleReply, error)
+	ArchiveArticle(ctx context.Context, in *ArchiveArticleRequest, opts ...grpc.CallOption) (*ArchiveArticleReply, error)
+	ListArticleRevisions(ctx context.Context, in *ListArticleRevisionsRequest, opts ...grpc.CallOption) (*ListArticleRevisionsReply, error)
+	GetArticleRevision(ctx context.Context, in *GetArticleRevisionRequest, opts ...grpc.CallOption) (*GetArticleRevisionReply, error)
+	DiffArticleRevisions(ctx context.Context, in *DiffArticleRevisionsRequest, opts ...grpc.CallOption) (*DiffArticleRevisionsReply, error)
+	RollbackArticle(ctx context.Context, in *RollbackArticleRequest, opts ...grpc.CallOption) (*RollbackArticleReply, error)
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagReply, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagReply, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagReply, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsReply, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryReply, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryReply, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryReply, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesReply, error)
 	ArticleCastJson(ctx context.Context, in *ArticleCastJsonRequest, opts ...grpc.CallOption) (*ArticleCastJsonReply, error)
 }
 
@@ -97,6 +142,226 @@ func (c *blogServiceClient) ListArticle(ctx context.Context, in *ListArticleRequ
 	return out, nil
 }
 
+func (c *blogServiceClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArticlesReply)
+	err := c.cc.Invoke(ctx, BlogService_SearchArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) LikeArticle(ctx context.Context, in *LikeArticleRequest, opts ...grpc.CallOption) (*LikeArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LikeArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_LikeArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UnlikeArticle(ctx context.Context, in *UnlikeArticleRequest, opts ...grpc.CallOption) (*UnlikeArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlikeArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_UnlikeArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListDeletedArticles(ctx context.Context, in *ListDeletedArticlesRequest, opts ...grpc.CallOption) (*ListDeletedArticlesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedArticlesReply)
+	err := c.cc.Invoke(ctx, BlogService_ListDeletedArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_RestoreArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) SubmitArticle(ctx context.Context, in *SubmitArticleRequest, opts ...grpc.CallOption) (*SubmitArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_SubmitArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ScheduleArticle(ctx context.Context, in *ScheduleArticleRequest, opts ...grpc.CallOption) (*ScheduleArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_ScheduleArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...grpc.CallOption) (*PublishArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_PublishArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UnpublishArticle(ctx context.Context, in *UnpublishArticleRequest, opts ...grpc.CallOption) (*UnpublishArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_UnpublishArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ArchiveArticle(ctx context.Context, in *ArchiveArticleRequest, opts ...grpc.CallOption) (*ArchiveArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_ArchiveArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListArticleRevisions(ctx context.Context, in *ListArticleRevisionsRequest, opts ...grpc.CallOption) (*ListArticleRevisionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArticleRevisionsReply)
+	err := c.cc.Invoke(ctx, BlogService_ListArticleRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) GetArticleRevision(ctx context.Context, in *GetArticleRevisionRequest, opts ...grpc.CallOption) (*GetArticleRevisionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArticleRevisionReply)
+	err := c.cc.Invoke(ctx, BlogService_GetArticleRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) DiffArticleRevisions(ctx context.Context, in *DiffArticleRevisionsRequest, opts ...grpc.CallOption) (*DiffArticleRevisionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffArticleRevisionsReply)
+	err := c.cc.Invoke(ctx, BlogService_DiffArticleRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) RollbackArticle(ctx context.Context, in *RollbackArticleRequest, opts ...grpc.CallOption) (*RollbackArticleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackArticleReply)
+	err := c.cc.Invoke(ctx, BlogService_RollbackArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTagReply)
+	err := c.cc.Invoke(ctx, BlogService_CreateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagReply)
+	err := c.cc.Invoke(ctx, BlogService_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagReply)
+	err := c.cc.Invoke(ctx, BlogService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsReply)
+	err := c.cc.Invoke(ctx, BlogService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryReply)
+	err := c.cc.Invoke(ctx, BlogService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryReply)
+	err := c.cc.Invoke(ctx, BlogService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryReply)
+	err := c.cc.Invoke(ctx, BlogService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesReply)
+	err := c.cc.Invoke(ctx, BlogService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blogServiceClient) ArticleCastJson(ctx context.Context, in *ArticleCastJsonRequest, opts ...grpc.CallOption) (*ArticleCastJsonReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ArticleCastJsonReply)
@@ -116,6 +381,29 @@ type BlogServiceServer interface {
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleReply, error)
 	GetArticle(context.Context, *GetArticleRequest) (*GetArticleReply, error)
 	ListArticle(context.Context, *ListArticleRequest) (*ListArticleReply, error)
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error)
+	LikeArticle(context.Context, *LikeArticleRequest) (*LikeArticleReply, error)
+	UnlikeArticle(context.Context, *UnlikeArticleRequest) (*UnlikeArticleReply, error)
+	ListDeletedArticles(context.Context, *ListDeletedArticlesRequest) (*ListDeletedArticlesReply, error)
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleReply, error)
+	// workflow: draft -> in_review -> scheduled -> published -> archived, see ArticleStatus
+	SubmitArticle(context.Context, *SubmitArticleRequest) (*SubmitArticleReply, error)
+	ScheduleArticle(context.Context, *ScheduleArticleRequest) (*ScheduleArticleReply, error)
+	PublishArticle(context.Context, *PublishArticleRequest) (*PublishArticleReply, error)
+	UnpublishArticle(context.Context, *UnpublishArticleRequest) (*UnpublishArticleReply, error)
+	ArchiveArticle(context.Context, *ArchiveArticleRequest) (*ArchiveArticleReply, error)
+	ListArticleRevisions(context.Context, *ListArticleRevisionsRequest) (*ListArticleRevisionsReply, error)
+	GetArticleRevision(context.Context, *GetArticleRevisionRequest) (*GetArticleRevisionReply, error)
+	DiffArticleRevisions(context.Context, *DiffArticleRevisionsRequest) (*DiffArticleRevisionsReply, error)
+	RollbackArticle(context.Context, *RollbackArticleRequest) (*RollbackArticleReply, error)
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagReply, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagReply, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagReply, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsReply, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryReply, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryReply, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryReply, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesReply, error)
 	ArticleCastJson(context.Context, *ArticleCastJsonRequest) (*ArticleCastJsonReply, error)
 	mustEmbedUnimplementedBlogServiceServer()
 }
@@ -142,6 +430,72 @@ func (UnimplementedBlogServiceServer) GetArticle(context.Context, *GetArticleReq
 func (UnimplementedBlogServiceServer) ListArticle(context.Context, *ListArticleRequest) (*ListArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListArticle not implemented")
 }
+func (UnimplementedBlogServiceServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
+func (UnimplementedBlogServiceServer) LikeArticle(context.Context, *LikeArticleRequest) (*LikeArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) UnlikeArticle(context.Context, *UnlikeArticleRequest) (*UnlikeArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) ListDeletedArticles(context.Context, *ListDeletedArticlesRequest) (*ListDeletedArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedArticles not implemented")
+}
+func (UnimplementedBlogServiceServer) RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) SubmitArticle(context.Context, *SubmitArticleRequest) (*SubmitArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) ScheduleArticle(context.Context, *ScheduleArticleRequest) (*ScheduleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) PublishArticle(context.Context, *PublishArticleRequest) (*PublishArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) UnpublishArticle(context.Context, *UnpublishArticleRequest) (*UnpublishArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) ArchiveArticle(context.Context, *ArchiveArticleRequest) (*ArchiveArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) ListArticleRevisions(context.Context, *ListArticleRevisionsRequest) (*ListArticleRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticleRevisions not implemented")
+}
+func (UnimplementedBlogServiceServer) GetArticleRevision(context.Context, *GetArticleRevisionRequest) (*GetArticleRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticleRevision not implemented")
+}
+func (UnimplementedBlogServiceServer) DiffArticleRevisions(context.Context, *DiffArticleRevisionsRequest) (*DiffArticleRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffArticleRevisions not implemented")
+}
+func (UnimplementedBlogServiceServer) RollbackArticle(context.Context, *RollbackArticleRequest) (*RollbackArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackArticle not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedBlogServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedBlogServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedBlogServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedBlogServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedBlogServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
 func (UnimplementedBlogServiceServer) ArticleCastJson(context.Context, *ArticleCastJsonRequest) (*ArticleCastJsonReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArticleCastJson not implemented")
 }
@@ -256,6 +610,402 @@ func _BlogService_ListArticle_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlogService_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_LikeArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).LikeArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_LikeArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).LikeArticle(ctx, req.(*LikeArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UnlikeArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlikeArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UnlikeArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UnlikeArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UnlikeArticle(ctx, req.(*UnlikeArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListDeletedArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListDeletedArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListDeletedArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListDeletedArticles(ctx, req.(*ListDeletedArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RestoreArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RestoreArticle(ctx, req.(*RestoreArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_SubmitArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).SubmitArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_SubmitArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).SubmitArticle(ctx, req.(*SubmitArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ScheduleArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ScheduleArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ScheduleArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ScheduleArticle(ctx, req.(*ScheduleArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_PublishArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).PublishArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_PublishArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).PublishArticle(ctx, req.(*PublishArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UnpublishArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UnpublishArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UnpublishArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UnpublishArticle(ctx, req.(*UnpublishArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ArchiveArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ArchiveArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ArchiveArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ArchiveArticle(ctx, req.(*ArchiveArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListArticleRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListArticleRevisions(ctx, req.(*ListArticleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_GetArticleRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).GetArticleRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_GetArticleRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).GetArticleRevision(ctx, req.(*GetArticleRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DiffArticleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffArticleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DiffArticleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DiffArticleRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DiffArticleRevisions(ctx, req.(*DiffArticleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_RollbackArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).RollbackArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_RollbackArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).RollbackArticle(ctx, req.(*RollbackArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlogService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlogService_ArticleCastJson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArticleCastJsonRequest)
 	if err := dec(in); err != nil {
@@ -301,6 +1051,94 @@ var BlogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListArticle",
 			Handler:    _BlogService_ListArticle_Handler,
 		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _BlogService_SearchArticles_Handler,
+		},
+		{
+			MethodName: "LikeArticle",
+			Handler:    _BlogService_LikeArticle_Handler,
+		},
+		{
+			MethodName: "UnlikeArticle",
+			Handler:    _BlogService_UnlikeArticle_Handler,
+		},
+		{
+			MethodName: "ListDeletedArticles",
+			Handler:    _BlogService_ListDeletedArticles_Handler,
+		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _BlogService_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "SubmitArticle",
+			Handler:    _BlogService_SubmitArticle_Handler,
+		},
+		{
+			MethodName: "ScheduleArticle",
+			Handler:    _BlogService_ScheduleArticle_Handler,
+		},
+		{
+			MethodName: "PublishArticle",
+			Handler:    _BlogService_PublishArticle_Handler,
+		},
+		{
+			MethodName: "UnpublishArticle",
+			Handler:    _BlogService_UnpublishArticle_Handler,
+		},
+		{
+			MethodName: "ArchiveArticle",
+			Handler:    _BlogService_ArchiveArticle_Handler,
+		},
+		{
+			MethodName: "ListArticleRevisions",
+			Handler:    _BlogService_ListArticleRevisions_Handler,
+		},
+		{
+			MethodName: "GetArticleRevision",
+			Handler:    _BlogService_GetArticleRevision_Handler,
+		},
+		{
+			MethodName: "DiffArticleRevisions",
+			Handler:    _BlogService_DiffArticleRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackArticle",
+			Handler:    _BlogService_RollbackArticle_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _BlogService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _BlogService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _BlogService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _BlogService_ListTags_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _BlogService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _BlogService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _BlogService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _BlogService_ListCategories_Handler,
+		},
 		{
 			MethodName: "ArticleCastJson",
 			Handler:    _BlogService_ArticleCastJson_Handler,
@@ -19,20 +19,65 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationBlogServiceArchiveArticle = "/blog.v1.BlogService/ArchiveArticle"
 const OperationBlogServiceArticleCastJson = "/blog.v1.BlogService/ArticleCastJson"
 const OperationBlogServiceCreateArticle = "/blog.v1.BlogService/CreateArticle"
+const OperationBlogServiceCreateCategory = "/blog.v1.BlogService/CreateCategory"
+const OperationBlogServiceCreateTag = "/blog.v1.BlogService/CreateTag"
 const OperationBlogServiceDeleteArticle = "/blog.v1.BlogService/DeleteArticle"
+const OperationBlogServiceDeleteCategory = "/blog.v1.BlogService/DeleteCategory"
+const OperationBlogServiceDeleteTag = "/blog.v1.BlogService/DeleteTag"
+const OperationBlogServiceDiffArticleRevisions = "/blog.v1.BlogService/DiffArticleRevisions"
 const OperationBlogServiceGetArticle = "/blog.v1.BlogService/GetArticle"
+const OperationBlogServiceGetArticleRevision = "/blog.v1.BlogService/GetArticleRevision"
+const OperationBlogServiceLikeArticle = "/blog.v1.BlogService/LikeArticle"
 const OperationBlogServiceListArticle = "/blog.v1.BlogService/ListArticle"
+const OperationBlogServiceListArticleRevisions = "/blog.v1.BlogService/ListArticleRevisions"
+const OperationBlogServiceListCategories = "/blog.v1.BlogService/ListCategories"
+const OperationBlogServiceListDeletedArticles = "/blog.v1.BlogService/ListDeletedArticles"
+const OperationBlogServiceListTags = "/blog.v1.BlogService/ListTags"
+const OperationBlogServicePublishArticle = "/blog.v1.BlogService/PublishArticle"
+const OperationBlogServiceRestoreArticle = "/blog.v1.BlogService/RestoreArticle"
+const OperationBlogServiceRollbackArticle = "/blog.v1.BlogService/RollbackArticle"
+const OperationBlogServiceScheduleArticle = "/blog.v1.BlogService/ScheduleArticle"
+const OperationBlogServiceSearchArticles = "/blog.v1.BlogService/SearchArticles"
+const OperationBlogServiceSubmitArticle = "/blog.v1.BlogService/SubmitArticle"
+const OperationBlogServiceUnlikeArticle = "/blog.v1.BlogService/UnlikeArticle"
+const OperationBlogServiceUnpublishArticle = "/blog.v1.BlogService/UnpublishArticle"
 const OperationBlogServiceUpdateArticle = "/blog.v1.BlogService/UpdateArticle"
+const OperationBlogServiceUpdateCategory = "/blog.v1.BlogService/UpdateCategory"
+const OperationBlogServiceUpdateTag = "/blog.v1.BlogService/UpdateTag"
 
 type BlogServiceHTTPServer interface {
+	ArchiveArticle(context.Context, *ArchiveArticleRequest) (*ArchiveArticleReply, error)
 	ArticleCastJson(context.Context, *ArticleCastJsonRequest) (*ArticleCastJsonReply, error)
 	CreateArticle(context.Context, *CreateArticleRequest) (*CreateArticleReply, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryReply, error)
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagReply, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*DeleteArticleReply, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryReply, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagReply, error)
+	DiffArticleRevisions(context.Context, *DiffArticleRevisionsRequest) (*DiffArticleRevisionsReply, error)
 	GetArticle(context.Context, *GetArticleRequest) (*GetArticleReply, error)
+	GetArticleRevision(context.Context, *GetArticleRevisionRequest) (*GetArticleRevisionReply, error)
+	LikeArticle(context.Context, *LikeArticleRequest) (*LikeArticleReply, error)
 	ListArticle(context.Context, *ListArticleRequest) (*ListArticleReply, error)
+	ListArticleRevisions(context.Context, *ListArticleRevisionsRequest) (*ListArticleRevisionsReply, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesReply, error)
+	ListDeletedArticles(context.Context, *ListDeletedArticlesRequest) (*ListDeletedArticlesReply, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsReply, error)
+	PublishArticle(context.Context, *PublishArticleRequest) (*PublishArticleReply, error)
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleReply, error)
+	RollbackArticle(context.Context, *RollbackArticleRequest) (*RollbackArticleReply, error)
+	ScheduleArticle(context.Context, *ScheduleArticleRequest) (*ScheduleArticleReply, error)
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error)
+	// SubmitArticle workflow: draft -> in_review -> scheduled -> published -> archived, see ArticleStatus
+	SubmitArticle(context.Context, *SubmitArticleRequest) (*SubmitArticleReply, error)
+	UnlikeArticle(context.Context, *UnlikeArticleRequest) (*UnlikeArticleReply, error)
+	UnpublishArticle(context.Context, *UnpublishArticleRequest) (*UnpublishArticleReply, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*UpdateArticleReply, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryReply, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagReply, error)
 }
 
 func RegisterBlogServiceHTTPServer(s *http.Server, srv BlogServiceHTTPServer) {
@@ -42,6 +87,28 @@ func RegisterBlogServiceHTTPServer(s *http.Server, srv BlogServiceHTTPServer) {
 	r.DELETE("/v1/article/{id}", _BlogService_DeleteArticle0_HTTP_Handler(srv))
 	r.GET("/v1/article/{id}", _BlogService_GetArticle0_HTTP_Handler(srv))
 	r.GET("/v1/article", _BlogService_ListArticle0_HTTP_Handler(srv))
+	r.GET("/v1/search/article", _BlogService_SearchArticles0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/like", _BlogService_LikeArticle0_HTTP_Handler(srv))
+	r.DELETE("/v1/article/{id}/like", _BlogService_UnlikeArticle0_HTTP_Handler(srv))
+	r.GET("/v1/trash/article", _BlogService_ListDeletedArticles0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/restore", _BlogService_RestoreArticle0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/submit", _BlogService_SubmitArticle0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/schedule", _BlogService_ScheduleArticle0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/publish", _BlogService_PublishArticle0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/unpublish", _BlogService_UnpublishArticle0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/archive", _BlogService_ArchiveArticle0_HTTP_Handler(srv))
+	r.GET("/v1/article/{id}/revisions", _BlogService_ListArticleRevisions0_HTTP_Handler(srv))
+	r.GET("/v1/article/{id}/revisions/{version}", _BlogService_GetArticleRevision0_HTTP_Handler(srv))
+	r.GET("/v1/article/{id}/diff", _BlogService_DiffArticleRevisions0_HTTP_Handler(srv))
+	r.POST("/v1/article/{id}/rollback", _BlogService_RollbackArticle0_HTTP_Handler(srv))
+	r.POST("/v1/tag", _BlogService_CreateTag0_HTTP_Handler(srv))
+	r.PUT("/v1/tag/{id}", _BlogService_UpdateTag0_HTTP_Handler(srv))
+	r.DELETE("/v1/tag/{id}", _BlogService_DeleteTag0_HTTP_Handler(srv))
+	r.GET("/v1/tag", _BlogService_ListTags0_HTTP_Handler(srv))
+	r.POST("/v1/category", _BlogService_CreateCategory0_HTTP_Handler(srv))
+	r.PUT("/v1/category/{id}", _BlogService_UpdateCategory0_HTTP_Handler(srv))
+	r.DELETE("/v1/category/{id}", _BlogService_DeleteCategory0_HTTP_Handler(srv))
+	r.GET("/v1/category", _BlogService_ListCategories0_HTTP_Handler(srv))
 	r.POST("/v1/article/castjson", _BlogService_ArticleCastJson0_HTTP_Handler(srv))
 }
 
@@ -155,6 +222,508 @@ func _BlogService_ListArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx
 	}
 }
 
+func _BlogService_SearchArticles0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceSearchArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchArticles(ctx, req.(*SearchArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_LikeArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LikeArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceLikeArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LikeArticle(ctx, req.(*LikeArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LikeArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_UnlikeArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlikeArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceUnlikeArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlikeArticle(ctx, req.(*UnlikeArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlikeArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_ListDeletedArticles0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletedArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceListDeletedArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedArticles(ctx, req.(*ListDeletedArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_RestoreArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceRestoreArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreArticle(ctx, req.(*RestoreArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_SubmitArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceSubmitArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitArticle(ctx, req.(*SubmitArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SubmitArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_ScheduleArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ScheduleArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceScheduleArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ScheduleArticle(ctx, req.(*ScheduleArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ScheduleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_PublishArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServicePublishArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishArticle(ctx, req.(*PublishArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PublishArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_UnpublishArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnpublishArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceUnpublishArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnpublishArticle(ctx, req.(*UnpublishArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnpublishArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_ArchiveArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ArchiveArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceArchiveArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ArchiveArticle(ctx, req.(*ArchiveArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ArchiveArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_ListArticleRevisions0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListArticleRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceListArticleRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListArticleRevisions(ctx, req.(*ListArticleRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListArticleRevisionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_GetArticleRevision0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetArticleRevisionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceGetArticleRevision)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetArticleRevision(ctx, req.(*GetArticleRevisionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetArticleRevisionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_DiffArticleRevisions0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffArticleRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceDiffArticleRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffArticleRevisions(ctx, req.(*DiffArticleRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffArticleRevisionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_RollbackArticle0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceRollbackArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackArticle(ctx, req.(*RollbackArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RollbackArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_CreateTag0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceCreateTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTag(ctx, req.(*CreateTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_UpdateTag0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceUpdateTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTag(ctx, req.(*UpdateTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_DeleteTag0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTagRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceDeleteTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTag(ctx, req.(*DeleteTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_ListTags0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceListTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTags(ctx, req.(*ListTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTagsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_CreateCategory0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCategoryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceCreateCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCategory(ctx, req.(*CreateCategoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCategoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_UpdateCategory0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCategoryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceUpdateCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCategoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_DeleteCategory0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCategoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceDeleteCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCategoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BlogService_ListCategories0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCategoriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBlogServiceListCategories)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCategories(ctx, req.(*ListCategoriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCategoriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _BlogService_ArticleCastJson0_HTTP_Handler(srv BlogServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ArticleCastJsonRequest
@@ -178,12 +747,34 @@ func _BlogService_ArticleCastJson0_HTTP_Handler(srv BlogServiceHTTPServer) func(
 }
 
 type BlogServiceHTTPClient interface {
+	ArchiveArticle(ctx context.Context, req *ArchiveArticleRequest, opts ...http.CallOption) (rsp *ArchiveArticleReply, err error)
 	ArticleCastJson(ctx context.Context, req *ArticleCastJsonRequest, opts ...http.CallOption) (rsp *ArticleCastJsonReply, err error)
 	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *CreateArticleReply, err error)
+	CreateCategory(ctx context.Context, req *CreateCategoryRequest, opts ...http.CallOption) (rsp *CreateCategoryReply, err error)
+	CreateTag(ctx context.Context, req *CreateTagRequest, opts ...http.CallOption) (rsp *CreateTagReply, err error)
 	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *DeleteArticleReply, err error)
+	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest, opts ...http.CallOption) (rsp *DeleteCategoryReply, err error)
+	DeleteTag(ctx context.Context, req *DeleteTagRequest, opts ...http.CallOption) (rsp *DeleteTagReply, err error)
+	DiffArticleRevisions(ctx context.Context, req *DiffArticleRevisionsRequest, opts ...http.CallOption) (rsp *DiffArticleRevisionsReply, err error)
 	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *GetArticleReply, err error)
+	GetArticleRevision(ctx context.Context, req *GetArticleRevisionRequest, opts ...http.CallOption) (rsp *GetArticleRevisionReply, err error)
+	LikeArticle(ctx context.Context, req *LikeArticleRequest, opts ...http.CallOption) (rsp *LikeArticleReply, err error)
 	ListArticle(ctx context.Context, req *ListArticleRequest, opts ...http.CallOption) (rsp *ListArticleReply, err error)
+	ListArticleRevisions(ctx context.Context, req *ListArticleRevisionsRequest, opts ...http.CallOption) (rsp *ListArticleRevisionsReply, err error)
+	ListCategories(ctx context.Context, req *ListCategoriesRequest, opts ...http.CallOption) (rsp *ListCategoriesReply, err error)
+	ListDeletedArticles(ctx context.Context, req *ListDeletedArticlesRequest, opts ...http.CallOption) (rsp *ListDeletedArticlesReply, err error)
+	ListTags(ctx context.Context, req *ListTagsRequest, opts ...http.CallOption) (rsp *ListTagsReply, err error)
+	PublishArticle(ctx context.Context, req *PublishArticleRequest, opts ...http.CallOption) (rsp *PublishArticleReply, err error)
+	RestoreArticle(ctx context.Context, req *RestoreArticleRequest, opts ...http.CallOption) (rsp *RestoreArticleReply, err error)
+	RollbackArticle(ctx context.Context, req *RollbackArticleRequest, opts ...http.CallOption) (rsp *RollbackArticleReply, err error)
+	ScheduleArticle(ctx context.Context, req *ScheduleArticleRequest, opts ...http.CallOption) (rsp *ScheduleArticleReply, err error)
+	SearchArticles(ctx context.Context, req *SearchArticlesRequest, opts ...http.CallOption) (rsp *SearchArticlesReply, err error)
+	SubmitArticle(ctx context.Context, req *SubmitArticleRequest, opts ...http.CallOption) (rsp *SubmitArticleReply, err error)
+	UnlikeArticle(ctx context.Context, req *UnlikeArticleRequest, opts ...http.CallOption) (rsp *UnlikeArticleReply, err error)
+	UnpublishArticle(ctx context.Context, req *UnpublishArticleRequest, opts ...http.CallOption) (rsp *UnpublishArticleReply, err error)
 	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *UpdateArticleReply, err error)
+	UpdateCategory(ctx context.Context, req *UpdateCategoryRequest, opts ...http.CallOption) (rsp *UpdateCategoryReply, err error)
+	UpdateTag(ctx context.Context, req *UpdateTagRequest, opts ...http.CallOption) (rsp *UpdateTagReply, err error)
 }
 
 type BlogServiceHTTPClientImpl struct {
@@ -194,6 +785,19 @@ func NewBlogServiceHTTPClient(client *http.Client) BlogServiceHTTPClient {
 	return &BlogServiceHTTPClientImpl{client}
 }
 
+func (c *BlogServiceHTTPClientImpl) ArchiveArticle(ctx context.Context, in *ArchiveArticleRequest, opts ...http.CallOption) (*ArchiveArticleReply, error) {
+	var out ArchiveArticleReply
+	pattern := "/v1/article/{id}/archive"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceArchiveArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BlogServiceHTTPClientImpl) ArticleCastJson(ctx context.Context, in *ArticleCastJsonRequest, opts ...http.CallOption) (*ArticleCastJsonReply, error) {
 	var out ArticleCastJsonReply
 	pattern := "/v1/article/castjson"
@@ -220,6 +824,32 @@ func (c *BlogServiceHTTPClientImpl) CreateArticle(ctx context.Context, in *Creat
 	return &out, nil
 }
 
+func (c *BlogServiceHTTPClientImpl) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...http.CallOption) (*CreateCategoryReply, error) {
+	var out CreateCategoryReply
+	pattern := "/v1/category"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceCreateCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...http.CallOption) (*CreateTagReply, error) {
+	var out CreateTagReply
+	pattern := "/v1/tag"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceCreateTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BlogServiceHTTPClientImpl) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...http.CallOption) (*DeleteArticleReply, error) {
 	var out DeleteArticleReply
 	pattern := "/v1/article/{id}"
@@ -233,6 +863,45 @@ func (c *BlogServiceHTTPClientImpl) DeleteArticle(ctx context.Context, in *Delet
 	return &out, nil
 }
 
+func (c *BlogServiceHTTPClientImpl) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...http.CallOption) (*DeleteCategoryReply, error) {
+	var out DeleteCategoryReply
+	pattern := "/v1/category/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceDeleteCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...http.CallOption) (*DeleteTagReply, error) {
+	var out DeleteTagReply
+	pattern := "/v1/tag/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceDeleteTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) DiffArticleRevisions(ctx context.Context, in *DiffArticleRevisionsRequest, opts ...http.CallOption) (*DiffArticleRevisionsReply, error) {
+	var out DiffArticleRevisionsReply
+	pattern := "/v1/article/{id}/diff"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceDiffArticleRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BlogServiceHTTPClientImpl) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...http.CallOption) (*GetArticleReply, error) {
 	var out GetArticleReply
 	pattern := "/v1/article/{id}"
@@ -246,6 +915,32 @@ func (c *BlogServiceHTTPClientImpl) GetArticle(ctx context.Context, in *GetArtic
 	return &out, nil
 }
 
+func (c *BlogServiceHTTPClientImpl) GetArticleRevision(ctx context.Context, in *GetArticleRevisionRequest, opts ...http.CallOption) (*GetArticleRevisionReply, error) {
+	var out GetArticleRevisionReply
+	pattern := "/v1/article/{id}/revisions/{version}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceGetArticleRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) LikeArticle(ctx context.Context, in *LikeArticleRequest, opts ...http.CallOption) (*LikeArticleReply, error) {
+	var out LikeArticleReply
+	pattern := "/v1/article/{id}/like"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceLikeArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BlogServiceHTTPClientImpl) ListArticle(ctx context.Context, in *ListArticleRequest, opts ...http.CallOption) (*ListArticleReply, error) {
 	var out ListArticleReply
 	pattern := "/v1/article"
@@ -259,6 +954,163 @@ func (c *BlogServiceHTTPClientImpl) ListArticle(ctx context.Context, in *ListArt
 	return &out, nil
 }
 
+func (c *BlogServiceHTTPClientImpl) ListArticleRevisions(ctx context.Context, in *ListArticleRevisionsRequest, opts ...http.CallOption) (*ListArticleRevisionsReply, error) {
+	var out ListArticleRevisionsReply
+	pattern := "/v1/article/{id}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceListArticleRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...http.CallOption) (*ListCategoriesReply, error) {
+	var out ListCategoriesReply
+	pattern := "/v1/category"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceListCategories))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) ListDeletedArticles(ctx context.Context, in *ListDeletedArticlesRequest, opts ...http.CallOption) (*ListDeletedArticlesReply, error) {
+	var out ListDeletedArticlesReply
+	pattern := "/v1/trash/article"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceListDeletedArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) ListTags(ctx context.Context, in *ListTagsRequest, opts ...http.CallOption) (*ListTagsReply, error) {
+	var out ListTagsReply
+	pattern := "/v1/tag"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceListTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) PublishArticle(ctx context.Context, in *PublishArticleRequest, opts ...http.CallOption) (*PublishArticleReply, error) {
+	var out PublishArticleReply
+	pattern := "/v1/article/{id}/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServicePublishArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...http.CallOption) (*RestoreArticleReply, error) {
+	var out RestoreArticleReply
+	pattern := "/v1/article/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceRestoreArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) RollbackArticle(ctx context.Context, in *RollbackArticleRequest, opts ...http.CallOption) (*RollbackArticleReply, error) {
+	var out RollbackArticleReply
+	pattern := "/v1/article/{id}/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceRollbackArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) ScheduleArticle(ctx context.Context, in *ScheduleArticleRequest, opts ...http.CallOption) (*ScheduleArticleReply, error) {
+	var out ScheduleArticleReply
+	pattern := "/v1/article/{id}/schedule"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceScheduleArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...http.CallOption) (*SearchArticlesReply, error) {
+	var out SearchArticlesReply
+	pattern := "/v1/search/article"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceSearchArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitArticle workflow: draft -> in_review -> scheduled -> published -> archived, see ArticleStatus
+func (c *BlogServiceHTTPClientImpl) SubmitArticle(ctx context.Context, in *SubmitArticleRequest, opts ...http.CallOption) (*SubmitArticleReply, error) {
+	var out SubmitArticleReply
+	pattern := "/v1/article/{id}/submit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceSubmitArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) UnlikeArticle(ctx context.Context, in *UnlikeArticleRequest, opts ...http.CallOption) (*UnlikeArticleReply, error) {
+	var out UnlikeArticleReply
+	pattern := "/v1/article/{id}/like"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBlogServiceUnlikeArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) UnpublishArticle(ctx context.Context, in *UnpublishArticleRequest, opts ...http.CallOption) (*UnpublishArticleReply, error) {
+	var out UnpublishArticleReply
+	pattern := "/v1/article/{id}/unpublish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceUnpublishArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BlogServiceHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...http.CallOption) (*UpdateArticleReply, error) {
 	var out UpdateArticleReply
 	pattern := "/v1/article/{id}"
@@ -271,3 +1123,29 @@ func (c *BlogServiceHTTPClientImpl) UpdateArticle(ctx context.Context, in *Updat
 	}
 	return &out, nil
 }
+
+func (c *BlogServiceHTTPClientImpl) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...http.CallOption) (*UpdateCategoryReply, error) {
+	var out UpdateCategoryReply
+	pattern := "/v1/category/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceUpdateCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BlogServiceHTTPClientImpl) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...http.CallOption) (*UpdateTagReply, error) {
+	var out UpdateTagReply
+	pattern := "/v1/tag/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBlogServiceUpdateTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
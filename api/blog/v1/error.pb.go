@@ -25,16 +25,110 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_BLOG_INVALID_ID ErrorReason = 0
+	ErrorReason_BLOG_INVALID_ID    ErrorReason = 0
+	ErrorReason_INVALID_PAGE_TOKEN ErrorReason = 1
+	ErrorReason_INVALID_ORDER_BY   ErrorReason = 2
+	ErrorReason_ARTICLE_NOT_FOUND  ErrorReason = 3
+	// the article was changed by someone else since it was read
+	ErrorReason_ARTICLE_CONFLICT ErrorReason = 4
+	// no longer returned, article titles need not be unique
+	ErrorReason_TITLE_DUPLICATE ErrorReason = 5
+	// redis, which keeps the like counters, can't be reached
+	ErrorReason_LIKE_STORE_UNAVAILABLE ErrorReason = 6
+	// unexpected failure, details are only logged
+	ErrorReason_BLOG_INTERNAL       ErrorReason = 7
+	ErrorReason_INVALID_UPDATE_MASK ErrorReason = 8
+	ErrorReason_REVISION_NOT_FOUND  ErrorReason = 9
+	// the workflow doesn't allow this move from the article's current status
+	ErrorReason_INVALID_STATUS_TRANSITION ErrorReason = 10
+	ErrorReason_INVALID_PUBLISH_AT        ErrorReason = 11
+	ErrorReason_TAG_NOT_FOUND             ErrorReason = 12
+	ErrorReason_TAG_DUPLICATE             ErrorReason = 13
+	ErrorReason_CATEGORY_NOT_FOUND        ErrorReason = 14
+	ErrorReason_CATEGORY_DUPLICATE        ErrorReason = 15
+	// the search query has no letter, digit or CJK character to match on
+	ErrorReason_INVALID_SEARCH_QUERY ErrorReason = 16
+	ErrorReason_COMMENT_NOT_FOUND    ErrorReason = 17
+	// the reply would nest deeper than comment.max_depth
+	ErrorReason_COMMENT_TOO_DEEP ErrorReason = 18
+	ErrorReason_USER_NOT_FOUND   ErrorReason = 19
+	ErrorReason_USERNAME_TAKEN   ErrorReason = 20
+	// unknown username or wrong password, deliberately not told apart
+	ErrorReason_INVALID_CREDENTIALS ErrorReason = 21
+	// the operation needs an authenticated caller
+	ErrorReason_UNAUTHENTICATED ErrorReason = 22
+	// the caller's role doesn't allow the operation, or only on resources it owns
+	ErrorReason_PERMISSION_DENIED ErrorReason = 23
+	// the role is not defined in auth.authz
+	ErrorReason_UNKNOWN_ROLE      ErrorReason = 24
+	ErrorReason_API_KEY_NOT_FOUND ErrorReason = 25
+	// scopes must be operation names or patterns like /blog.v1.BlogService/*
+	ErrorReason_INVALID_SCOPE ErrorReason = 26
+	// redis, which keeps the view counters, can't be reached
+	ErrorReason_VIEW_STORE_UNAVAILABLE ErrorReason = 27
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "BLOG_INVALID_ID",
+		0:  "BLOG_INVALID_ID",
+		1:  "INVALID_PAGE_TOKEN",
+		2:  "INVALID_ORDER_BY",
+		3:  "ARTICLE_NOT_FOUND",
+		4:  "ARTICLE_CONFLICT",
+		5:  "TITLE_DUPLICATE",
+		6:  "LIKE_STORE_UNAVAILABLE",
+		7:  "BLOG_INTERNAL",
+		8:  "INVALID_UPDATE_MASK",
+		9:  "REVISION_NOT_FOUND",
+		10: "INVALID_STATUS_TRANSITION",
+		11: "INVALID_PUBLISH_AT",
+		12: "TAG_NOT_FOUND",
+		13: "TAG_DUPLICATE",
+		14: "CATEGORY_NOT_FOUND",
+		15: "CATEGORY_DUPLICATE",
+		16: "INVALID_SEARCH_QUERY",
+		17: "COMMENT_NOT_FOUND",
+		18: "COMMENT_TOO_DEEP",
+		19: "USER_NOT_FOUND",
+		20: "USERNAME_TAKEN",
+		21: "INVALID_CREDENTIALS",
+		22: "UNAUTHENTICATED",
+		23: "PERMISSION_DENIED",
+		24: "UNKNOWN_ROLE",
+		25: "API_KEY_NOT_FOUND",
+		26: "INVALID_SCOPE",
+		27: "VIEW_STORE_UNAVAILABLE",
 	}
 	ErrorReason_value = map[string]int32{
-		"BLOG_INVALID_ID": 0,
+		"BLOG_INVALID_ID":           0,
+		"INVALID_PAGE_TOKEN":        1,
+		"INVALID_ORDER_BY":          2,
+		"ARTICLE_NOT_FOUND":         3,
+		"ARTICLE_CONFLICT":          4,
+		"TITLE_DUPLICATE":           5,
+		"LIKE_STORE_UNAVAILABLE":    6,
+		"BLOG_INTERNAL":             7,
+		"INVALID_UPDATE_MASK":       8,
+		"REVISION_NOT_FOUND":        9,
+		"INVALID_STATUS_TRANSITION": 10,
+		"INVALID_PUBLISH_AT":        11,
+		"TAG_NOT_FOUND":             12,
+		"TAG_DUPLICATE":             13,
+		"CATEGORY_NOT_FOUND":        14,
+		"CATEGORY_DUPLICATE":        15,
+		"INVALID_SEARCH_QUERY":      16,
+		"COMMENT_NOT_FOUND":         17,
+		"COMMENT_TOO_DEEP":          18,
+		"USER_NOT_FOUND":            19,
+		"USERNAME_TAKEN":            20,
+		"INVALID_CREDENTIALS":       21,
+		"UNAUTHENTICATED":           22,
+		"PERMISSION_DENIED":         23,
+		"UNKNOWN_ROLE":              24,
+		"API_KEY_NOT_FOUND":         25,
+		"INVALID_SCOPE":             26,
+		"VIEW_STORE_UNAVAILABLE":    27,
 	}
 )
 
@@ -69,9 +163,37 @@ var File_api_blog_v1_error_proto protoreflect.FileDescriptor
 
 const file_api_blog_v1_error_proto_rawDesc = "" +
 	"\n" +
-	"\x17api/blog/v1/error.proto\x12\ablog.v1\x1a\x13errors/errors.proto*(\n" +
-	"\vErrorReason\x12\x13\n" +
-	"\x0fBLOG_INVALID_ID\x10\x00\x1a\x04\xa0E\xf4\x03B\x17Z\x15agdemo/api/blog/v1;v1b\x06proto3"
+	"\x17api/blog/v1/error.proto\x12\ablog.v1\x1a\x13errors/errors.proto*\xb3\x06\n" +
+	"\vErrorReason\x12\x19\n" +
+	"\x0fBLOG_INVALID_ID\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12INVALID_PAGE_TOKEN\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10INVALID_ORDER_BY\x10\x02\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11ARTICLE_NOT_FOUND\x10\x03\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
+	"\x10ARTICLE_CONFLICT\x10\x04\x1a\x04\xa8E\x99\x03\x12\x19\n" +
+	"\x0fTITLE_DUPLICATE\x10\x05\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x16LIKE_STORE_UNAVAILABLE\x10\x06\x1a\x04\xa8E\xf7\x03\x12\x11\n" +
+	"\rBLOG_INTERNAL\x10\a\x12\x1d\n" +
+	"\x13INVALID_UPDATE_MASK\x10\b\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12REVISION_NOT_FOUND\x10\t\x1a\x04\xa8E\x94\x03\x12#\n" +
+	"\x19INVALID_STATUS_TRANSITION\x10\n" +
+	"\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12INVALID_PUBLISH_AT\x10\v\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rTAG_NOT_FOUND\x10\f\x1a\x04\xa8E\x94\x03\x12\x17\n" +
+	"\rTAG_DUPLICATE\x10\r\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12CATEGORY_NOT_FOUND\x10\x0e\x1a\x04\xa8E\x94\x03\x12\x1c\n" +
+	"\x12CATEGORY_DUPLICATE\x10\x0f\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x14INVALID_SEARCH_QUERY\x10\x10\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11COMMENT_NOT_FOUND\x10\x11\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
+	"\x10COMMENT_TOO_DEEP\x10\x12\x1a\x04\xa8E\x90\x03\x12\x18\n" +
+	"\x0eUSER_NOT_FOUND\x10\x13\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\x0eUSERNAME_TAKEN\x10\x14\x1a\x04\xa8E\x99\x03\x12\x1d\n" +
+	"\x13INVALID_CREDENTIALS\x10\x15\x1a\x04\xa8E\x91\x03\x12\x19\n" +
+	"\x0fUNAUTHENTICATED\x10\x16\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x11PERMISSION_DENIED\x10\x17\x1a\x04\xa8E\x93\x03\x12\x16\n" +
+	"\fUNKNOWN_ROLE\x10\x18\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11API_KEY_NOT_FOUND\x10\x19\x1a\x04\xa8E\x94\x03\x12\x17\n" +
+	"\rINVALID_SCOPE\x10\x1a\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x16VIEW_STORE_UNAVAILABLE\x10\x1b\x1a\x04\xa8E\xf7\x03\x1a\x04\xa0E\xf4\x03B\x17Z\x15agdemo/api/blog/v1;v1b\x06proto3"
 
 var (
 	file_api_blog_v1_error_proto_rawDescOnce sync.Once
@@ -16,9 +16,359 @@ func IsBlogInvalidId(err error) bool {
 		return false
 	}
 	e := errors.FromError(err)
-	return e.Reason == ErrorReason_BLOG_INVALID_ID.String() && e.Code == 500
+	return e.Reason == ErrorReason_BLOG_INVALID_ID.String() && e.Code == 400
 }
 
 func ErrorBlogInvalidId(format string, args ...interface{}) *errors.Error {
-	return errors.New(500, ErrorReason_BLOG_INVALID_ID.String(), fmt.Sprintf(format, args...))
+	return errors.New(400, ErrorReason_BLOG_INVALID_ID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidPageToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_PAGE_TOKEN.String() && e.Code == 400
+}
+
+func ErrorInvalidPageToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_PAGE_TOKEN.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidOrderBy(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_ORDER_BY.String() && e.Code == 400
+}
+
+func ErrorInvalidOrderBy(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_ORDER_BY.String(), fmt.Sprintf(format, args...))
+}
+
+func IsArticleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ARTICLE_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorArticleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_ARTICLE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// the article was changed by someone else since it was read
+func IsArticleConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ARTICLE_CONFLICT.String() && e.Code == 409
+}
+
+// the article was changed by someone else since it was read
+func ErrorArticleConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_ARTICLE_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+// no longer returned, article titles need not be unique
+func IsTitleDuplicate(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TITLE_DUPLICATE.String() && e.Code == 409
+}
+
+// no longer returned, article titles need not be unique
+func ErrorTitleDuplicate(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_TITLE_DUPLICATE.String(), fmt.Sprintf(format, args...))
+}
+
+// redis, which keeps the like counters, can't be reached
+func IsLikeStoreUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_LIKE_STORE_UNAVAILABLE.String() && e.Code == 503
+}
+
+// redis, which keeps the like counters, can't be reached
+func ErrorLikeStoreUnavailable(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ErrorReason_LIKE_STORE_UNAVAILABLE.String(), fmt.Sprintf(format, args...))
+}
+
+// unexpected failure, details are only logged
+func IsBlogInternal(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_BLOG_INTERNAL.String() && e.Code == 500
+}
+
+// unexpected failure, details are only logged
+func ErrorBlogInternal(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_BLOG_INTERNAL.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidUpdateMask(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_UPDATE_MASK.String() && e.Code == 400
+}
+
+func ErrorInvalidUpdateMask(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_UPDATE_MASK.String(), fmt.Sprintf(format, args...))
+}
+
+func IsRevisionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REVISION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorRevisionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_REVISION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// the workflow doesn't allow this move from the article's current status
+func IsInvalidStatusTransition(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_STATUS_TRANSITION.String() && e.Code == 409
+}
+
+// the workflow doesn't allow this move from the article's current status
+func ErrorInvalidStatusTransition(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_INVALID_STATUS_TRANSITION.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidPublishAt(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_PUBLISH_AT.String() && e.Code == 400
+}
+
+func ErrorInvalidPublishAt(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_PUBLISH_AT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTagNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TAG_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorTagNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_TAG_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTagDuplicate(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TAG_DUPLICATE.String() && e.Code == 409
+}
+
+func ErrorTagDuplicate(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_TAG_DUPLICATE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCategoryNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CATEGORY_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorCategoryNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_CATEGORY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCategoryDuplicate(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CATEGORY_DUPLICATE.String() && e.Code == 409
+}
+
+func ErrorCategoryDuplicate(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_CATEGORY_DUPLICATE.String(), fmt.Sprintf(format, args...))
+}
+
+// the search query has no letter, digit or CJK character to match on
+func IsInvalidSearchQuery(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_SEARCH_QUERY.String() && e.Code == 400
+}
+
+// the search query has no letter, digit or CJK character to match on
+func ErrorInvalidSearchQuery(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_SEARCH_QUERY.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCommentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_COMMENT_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorCommentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_COMMENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// the reply would nest deeper than comment.max_depth
+func IsCommentTooDeep(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_COMMENT_TOO_DEEP.String() && e.Code == 400
+}
+
+// the reply would nest deeper than comment.max_depth
+func ErrorCommentTooDeep(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_COMMENT_TOO_DEEP.String(), fmt.Sprintf(format, args...))
+}
+
+func IsUserNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_USER_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorUserNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_USER_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsUsernameTaken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_USERNAME_TAKEN.String() && e.Code == 409
+}
+
+func ErrorUsernameTaken(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_USERNAME_TAKEN.String(), fmt.Sprintf(format, args...))
+}
+
+// unknown username or wrong password, deliberately not told apart
+func IsInvalidCredentials(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_CREDENTIALS.String() && e.Code == 401
+}
+
+// unknown username or wrong password, deliberately not told apart
+func ErrorInvalidCredentials(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_INVALID_CREDENTIALS.String(), fmt.Sprintf(format, args...))
+}
+
+// the operation needs an authenticated caller
+func IsUnauthenticated(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_UNAUTHENTICATED.String() && e.Code == 401
+}
+
+// the operation needs an authenticated caller
+func ErrorUnauthenticated(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_UNAUTHENTICATED.String(), fmt.Sprintf(format, args...))
+}
+
+// the caller's role doesn't allow the operation, or only on resources it owns
+func IsPermissionDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PERMISSION_DENIED.String() && e.Code == 403
+}
+
+// the caller's role doesn't allow the operation, or only on resources it owns
+func ErrorPermissionDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_PERMISSION_DENIED.String(), fmt.Sprintf(format, args...))
+}
+
+// the role is not defined in auth.authz
+func IsUnknownRole(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_UNKNOWN_ROLE.String() && e.Code == 400
+}
+
+// the role is not defined in auth.authz
+func ErrorUnknownRole(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_UNKNOWN_ROLE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsApiKeyNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_API_KEY_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorApiKeyNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_API_KEY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// scopes must be operation names or patterns like /blog.v1.BlogService/*
+func IsInvalidScope(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_SCOPE.String() && e.Code == 400
+}
+
+// scopes must be operation names or patterns like /blog.v1.BlogService/*
+func ErrorInvalidScope(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_SCOPE.String(), fmt.Sprintf(format, args...))
+}
+
+// redis, which keeps the view counters, can't be reached
+func IsViewStoreUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_VIEW_STORE_UNAVAILABLE.String() && e.Code == 503
+}
+
+// redis, which keeps the view counters, can't be reached
+func ErrorViewStoreUnavailable(format string, args ...interface{}) *errors.Error {
+	return errors.New(503, ErrorReason_VIEW_STORE_UNAVAILABLE.String(), fmt.Sprintf(format, args...))
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"agdemo/internal/conf"
 	"agdemo/internal/server"
+	"agdemo/internal/service"
 	"flag"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/config"
@@ -30,9 +31,14 @@ var (
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] [migrate up|down|status|to <version>]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, as *server.AdminServer, lf *server.LikeFlusher, tp *server.TrashPurger, ps *server.PublishScheduler,
+	sr *server.SearchRefresher, health *service.HealthService) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -42,7 +48,14 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			as,
+			lf,
+			tp,
+			ps,
+			sr,
 		),
+		// 先让就绪检查失败，负载均衡摘除后再停止各个 server
+		kratos.BeforeStop(health.Drain),
 	)
 }
 
@@ -54,13 +67,21 @@ func main() {
 	if err != nil {
 		// 处理错误，但不要panic，让服务继续运行
 		// 可以使用日志记录错误
-		log.Error("Failed to initialize tracer: %v", err)
+		log.Error("Failed to initialize tracer: ", err)
 
 	}
 
 	// 确保在程序退出时关闭tracer
 	defer shutdown()
 
+	// 初始化Meter，admin 端口的 /metrics 导出 Prometheus 指标
+	shutdownMeter, err := server.InitMeter("blog-service")
+	if err != nil {
+		log.Errorf("Failed to initialize meter: %v", err)
+	} else {
+		defer shutdownMeter()
+	}
+
 	// 初始化 logrus 日志
 	logger := setupLogrus()
 
@@ -80,10 +101,23 @@ func main() {
 	if err := c.Scan(&bc); err != nil {
 		panic(err)
 	}
-	// 打印加载的配置
-	fmt.Printf("Loaded config: %+v\n", bc)
+	// 只打印配置来源，配置中含有 DSN 与密钥
+	fmt.Printf("Loaded config from %s\n", flagconf)
+
+	// 子命令：migrate
+	if args := flag.Args(); len(args) > 0 {
+		if args[0] != "migrate" {
+			flag.Usage()
+			os.Exit(2)
+		}
+		if err := runMigrate(bc.Data, args[1:], logger); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"agdemo/internal/conf"
+	"agdemo/internal/data"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = "usage: agdemo -conf <path> migrate up|down|status|to <version>"
+
+// runMigrate 执行 migrate 子命令
+func runMigrate(c *conf.Data, args []string, logger log.Logger) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	db, err := data.NewDB(c, logger)
+	if err != nil {
+		return err
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+	m, err := data.NewMigrator(c, db, logger)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		ms, err := m.Up(ctx)
+		for _, mg := range ms {
+			fmt.Printf("applied %d_%s\n", mg.Version, mg.Name)
+		}
+		if err == nil && len(ms) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+	case "down":
+		mg, err := m.Down(ctx)
+		if mg != nil && err == nil {
+			fmt.Printf("rolled back %d_%s\n", mg.Version, mg.Name)
+		} else if mg == nil && err == nil {
+			fmt.Println("nothing to roll back")
+		}
+		return err
+	case "to":
+		if len(args) != 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		ms, err := m.To(ctx, version)
+		for _, mg := range ms {
+			fmt.Printf("migrated %d_%s\n", mg.Version, mg.Name)
+		}
+		return err
+	case "status":
+		ss, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, s := range ss {
+			status, at := "pending", ""
+			if s.Applied {
+				status, at = "applied", s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, status, at)
+		}
+		return w.Flush()
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"agdemo/internal/biz"
 	"agdemo/internal/conf"
 	"agdemo/internal/data"
+	"agdemo/internal/middleware"
 	"agdemo/internal/server"
 	"agdemo/internal/service"
 	"github.com/go-kratos/kratos/v2"
@@ -23,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
 	db, err := data.NewDB(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -36,12 +37,67 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	articleRepo := data.NewArticleRepo(dataData, logger)
-	articleUsecase := biz.NewArticleUsecase(articleRepo, logger)
-	blogService := service.NewBlogService(articleUsecase, logger)
-	grpcServer := server.NewGRPCServer(confServer, blogService, logger)
-	httpServer := server.NewHTTPServer(confServer, blogService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	articleRepo := data.NewArticleRepo(confData, dataData, logger)
+	locker := data.NewLocker(dataData, logger)
+	articleSearcher, err := data.NewArticleSearcher(confData, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	articleUsecase := biz.NewArticleUsecase(auth, articleRepo, locker, articleSearcher, logger)
+	taxonomyRepo := data.NewTaxonomyRepo(confData, dataData, logger)
+	taxonomyUsecase := biz.NewTaxonomyUsecase(taxonomyRepo, logger)
+	blogService := service.NewBlogService(articleUsecase, taxonomyUsecase, logger)
+	commentRepo := data.NewCommentRepo(confData, dataData, logger)
+	commentUsecase := biz.NewCommentUsecase(confData, commentRepo, articleRepo, logger)
+	commentService := service.NewCommentService(commentUsecase, logger)
+	userRepo := data.NewUserRepo(confData, dataData, logger)
+	jwt, err := middleware.NewJWT(auth, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userUsecase, err := biz.NewUserUsecase(auth, userRepo, jwt, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userService := service.NewUserService(userUsecase, logger)
+	apiKeyRepo := data.NewApiKeyRepo(dataData, logger)
+	apiKeyUsecase := biz.NewApiKeyUsecase(apiKeyRepo, logger)
+	apiKeyService := service.NewApiKeyService(apiKeyUsecase, logger)
+	dependencyRepo := data.NewDependencyRepo(confData, dataData)
+	healthUsecase := biz.NewHealthUsecase(confServer, dependencyRepo, logger)
+	healthService := service.NewHealthService(healthUsecase, logger)
+	apiKey := middleware.NewAPIKey(apiKeyUsecase)
+	authz, err := middleware.NewAuthz(auth, articleUsecase, userUsecase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	rateLimit, err := middleware.NewRateLimit(confServer, client, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	adaptive, err := middleware.NewAdaptive(confServer)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	metrics, err := middleware.NewMetrics()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer := server.NewGRPCServer(confServer, blogService, commentService, userService, apiKeyService, healthService, apiKey, jwt, authz, rateLimit, adaptive, metrics, logger)
+	httpServer := server.NewHTTPServer(confServer, blogService, commentService, userService, apiKeyService, healthService, apiKey, jwt, authz, rateLimit, adaptive, metrics, logger)
+	adminServer := server.NewAdminServer(confServer)
+	likeFlusher := server.NewLikeFlusher(confData, articleUsecase, logger)
+	trashPurger := server.NewTrashPurger(confData, articleUsecase, logger)
+	publishScheduler := server.NewPublishScheduler(confData, articleUsecase, logger)
+	searchRefresher := server.NewSearchRefresher(confData, articleUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, adminServer, likeFlusher, trashPurger, publishScheduler, searchRefresher, healthService)
 	return app, func() {
 		cleanup()
 	}, nil
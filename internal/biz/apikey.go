@@ -0,0 +1,232 @@
+package biz
+
+import (
+	pb "agdemo/api/blog/v1"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrApiKeyNotFound is api key not found.
+	ErrApiKeyNotFound = errors.NotFound(pb.ErrorReason_API_KEY_NOT_FOUND.String(), "api key not found")
+	// ErrInvalidScope is returned for a scope that is not an operation name or pattern.
+	ErrInvalidScope = errors.BadRequest(pb.ErrorReason_INVALID_SCOPE.String(), "invalid scope")
+	// ErrInvalidApiKey is returned for an unknown, malformed, expired or revoked key.
+	ErrInvalidApiKey = errors.Unauthorized(pb.ErrorReason_UNAUTHENTICATED.String(), "invalid api key")
+	// ErrApiKeyPrefixTaken is returned by the repo when another key has the prefix, minting then retries.
+	ErrApiKeyPrefixTaken = stderrors.New("api key prefix taken")
+)
+
+const (
+	// apiKeyTag 开头便于在日志和代码仓库里扫描泄露的密钥
+	apiKeyTag = "agk"
+	// touchInterval last_used_at 最多每分钟写一次
+	touchInterval = time.Minute
+	// apiKeyPrefixLen 前缀的随机字节数，编码后 16 个十六进制字符
+	apiKeyPrefixLen = 8
+	// apiKeyMintAttempts 前缀冲突时重新生成的次数
+	apiKeyMintAttempts = 3
+)
+
+type ApiKey struct {
+	Id         int64
+	Name       string
+	Prefix     string
+	KeyHash    string // hex sha256 of the whole key
+	Scopes     []string
+	CreatedBy  int64
+	CreatedAt  time.Time
+	ExpiresAt  time.Time // zero when the key doesn't expire
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+}
+
+func (k *ApiKey) ToProto() *pb.ApiKey {
+	return &pb.ApiKey{
+		Id:         k.Id,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  timestamp(k.CreatedAt),
+		ExpiresAt:  timestamp(k.ExpiresAt),
+		LastUsedAt: timestamp(k.LastUsedAt),
+		RevokedAt:  timestamp(k.RevokedAt),
+	}
+}
+
+// Active reports whether the key can be used at t.
+func (k *ApiKey) Active(t time.Time) bool {
+	return k.RevokedAt.IsZero() && (k.ExpiresAt.IsZero() || t.Before(k.ExpiresAt))
+}
+
+type ApiKeyPage struct {
+	Keys          []*ApiKey
+	NextPageToken string
+}
+
+type ApiKeyRepo interface {
+	// CreateApiKey fails with ErrApiKeyPrefixTaken when the prefix is in use.
+	CreateApiKey(ctx context.Context, k *ApiKey) error
+	GetApiKeyByPrefix(ctx context.Context, prefix string) (*ApiKey, error)
+	// ListApiKeys returns at most limit keys older than id before, 0 for the newest, newest first.
+	ListApiKeys(ctx context.Context, before int64, limit int, includeRevoked bool) ([]*ApiKey, error)
+	// RevokeApiKey sets revoked_at unless already set and returns the key.
+	RevokeApiKey(ctx context.Context, id int64, t time.Time) (*ApiKey, error)
+	TouchApiKey(ctx context.Context, id int64, t time.Time) error
+}
+
+type ApiKeyUsecase struct {
+	repo ApiKeyRepo
+	log  *log.Helper
+}
+
+func NewApiKeyUsecase(repo ApiKeyRepo, logger log.Logger) *ApiKeyUsecase {
+	return &ApiKeyUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func hashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// parseApiKey 密钥形如 agk_<prefix>_<secret>，prefix 用于查找，secret 只以哈希形式存储
+func parseApiKey(key string) (prefix string, ok bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) < 3 || parts[0] != apiKeyTag || parts[1] == "" {
+		return "", false
+	}
+	// base64url 的 secret 自身可能含 '_'
+	return parts[1], true
+}
+
+func checkScopes(scopes []string) error {
+	for _, s := range scopes {
+		if s != "*" && (!strings.HasPrefix(s, "/") || strings.Contains(strings.TrimSuffix(s, "*"), "*")) {
+			return errors.BadRequest(ErrInvalidScope.Reason, "invalid scope "+s)
+		}
+	}
+	return nil
+}
+
+// Create mints a key on behalf of the caller and returns it in plain text,
+// which is the only time it is available. The key can do what its scopes
+// allow and the role of the caller still allows when it is used.
+func (uc *ApiKeyUsecase) Create(ctx context.Context, k *ApiKey, ttl time.Duration) (string, error) {
+	c, ok := CallerFromContext(ctx)
+	if !ok {
+		return "", ErrUnauthenticated
+	}
+	if err := checkScopes(k.Scopes); err != nil {
+		return "", err
+	}
+	k.CreatedBy = c.UserId
+	if ttl > 0 {
+		k.ExpiresAt = time.Now().Add(ttl)
+	}
+	for i := 0; i < apiKeyMintAttempts; i++ {
+		key, err := mintApiKey()
+		if err != nil {
+			return "", err
+		}
+		k.Prefix, _ = parseApiKey(key)
+		k.KeyHash = hashApiKey(key)
+		err = uc.repo.CreateApiKey(ctx, k)
+		if err == nil {
+			return key, nil
+		}
+		if !stderrors.Is(err, ErrApiKeyPrefixTaken) {
+			return "", err
+		}
+		uc.log.WithContext(ctx).Warnf("api key prefix %s taken, minting another", k.Prefix)
+	}
+	return "", fmt.Errorf("mint api key: prefix taken %d times in a row", apiKeyMintAttempts)
+}
+
+// mintApiKey 生成 agk_<prefix>_<secret> 形式的新密钥
+func mintApiKey() (string, error) {
+	p, err := randomBytes(apiKeyPrefixLen)
+	if err != nil {
+		return "", err
+	}
+	secret, err := randomBytes(24)
+	if err != nil {
+		return "", err
+	}
+	return apiKeyTag + "_" + hex.EncodeToString(p) + "_" + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func (uc *ApiKeyUsecase) List(ctx context.Context, pageSize int, pageToken string, includeRevoked bool) (*ApiKeyPage, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	var before int64
+	if pageToken != "" {
+		v, err := decodeInt64Token(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		before = v
+	}
+	list, err := uc.repo.ListApiKeys(ctx, before, pageSize+1, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	page := &ApiKeyPage{Keys: list}
+	if len(list) > pageSize {
+		page.Keys = list[:pageSize]
+		page.NextPageToken = encodeInt64Token(page.Keys[pageSize-1].Id)
+	}
+	return page, nil
+}
+
+func (uc *ApiKeyUsecase) Revoke(ctx context.Context, id int64) (*ApiKey, error) {
+	return uc.repo.RevokeApiKey(ctx, id, time.Now())
+}
+
+// Authenticate returns the active key matching the plain text key.
+func (uc *ApiKeyUsecase) Authenticate(ctx context.Context, key string) (*ApiKey, error) {
+	prefix, ok := parseApiKey(key)
+	if !ok {
+		return nil, ErrInvalidApiKey
+	}
+	k, err := uc.repo.GetApiKeyByPrefix(ctx, prefix)
+	if ErrApiKeyNotFound.Is(err) {
+		return nil, ErrInvalidApiKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(k.KeyHash), []byte(hashApiKey(key))) != 1 {
+		return nil, ErrInvalidApiKey
+	}
+	now := time.Now()
+	if !k.Active(now) {
+		return nil, ErrInvalidApiKey
+	}
+	if now.Sub(k.LastUsedAt) >= touchInterval {
+		if err = uc.repo.TouchApiKey(ctx, k.Id, now); err != nil {
+			uc.log.WithContext(ctx).Warnf("touch api key %d: %v", k.Id, err)
+		}
+	}
+	return k, nil
+}
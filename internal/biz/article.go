@@ -2,12 +2,44 @@ package biz
 
 import (
 	pb "agdemo/api/blog/v1"
+	"agdemo/internal/conf"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var (
+	// ErrInvalidId is returned for a non-positive article id.
+	ErrInvalidId = errors.BadRequest(pb.ErrorReason_BLOG_INVALID_ID.String(), "invalid id")
+	// ErrArticleNotFound is article not found.
+	ErrArticleNotFound = errors.NotFound(pb.ErrorReason_ARTICLE_NOT_FOUND.String(), "article not found")
+	// ErrArticleConflict is returned when an article was modified concurrently.
+	ErrArticleConflict = errors.Conflict(pb.ErrorReason_ARTICLE_CONFLICT.String(), "article was modified concurrently")
+	// ErrLikeStoreUnavailable is returned when the like counters can't be read or written.
+	ErrLikeStoreUnavailable = errors.ServiceUnavailable(pb.ErrorReason_LIKE_STORE_UNAVAILABLE.String(), "like store unavailable")
+	// ErrViewStoreUnavailable is returned when the view counters can't be read or written.
+	ErrViewStoreUnavailable = errors.ServiceUnavailable(pb.ErrorReason_VIEW_STORE_UNAVAILABLE.String(), "view store unavailable")
+	// ErrInvalidUpdateMask is returned when update_mask names an unknown field or a masked field is empty.
+	ErrInvalidUpdateMask = errors.BadRequest(pb.ErrorReason_INVALID_UPDATE_MASK.String(), "invalid update_mask")
+	// ErrInvalidPageToken is returned when a page token is malformed or was issued for another ordering.
+	ErrInvalidPageToken = errors.BadRequest(pb.ErrorReason_INVALID_PAGE_TOKEN.String(), "invalid page token")
+	// ErrInvalidOrderBy is returned when order_by names an unsupported column or direction.
+	ErrInvalidOrderBy = errors.BadRequest(pb.ErrorReason_INVALID_ORDER_BY.String(), "invalid order_by")
+)
+
 type Article struct {
 	Id        int64
 	Title     string
@@ -15,72 +47,588 @@ type Article struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Like      int64
+	Views     int64
+	Version   int64
+	DeletedAt time.Time // zero unless the article is in the trash
+
+	Status      ArticleStatus
+	PublishAt   time.Time // zero unless scheduled
+	PublishedAt time.Time // zero unless published
+
+	Tags       []string // tag names, sorted
+	CategoryId int64    // 0 when uncategorized
+
+	CommentCount int64 // approved comments
+
+	AuthorId int64 // 0 for articles written before accounts existed
+	Author   *User // id, username and display name only, nil when AuthorId is 0
 }
 
 func (a *Article) ToProto() *pb.Article {
 	return &pb.Article{
-		Id:      a.Id,
-		Title:   a.Title,
-		Content: a.Content,
-		Like:    a.Like, // 确保不遗漏字段
+		Id:        a.Id,
+		Title:     a.Title,
+		Content:   a.Content,
+		Like:      a.Like, // 确保不遗漏字段
+		ViewCount: a.Views,
+		Version:   a.Version,
+		DeletedAt: timestamp(a.DeletedAt),
+
+		Status:      a.Status.ToProto(),
+		PublishAt:   timestamp(a.PublishAt),
+		PublishedAt: timestamp(a.PublishedAt),
+
+		Tags:       a.Tags,
+		CategoryId: a.CategoryId,
+
+		CommentCount: a.CommentCount,
+
+		AuthorId: a.AuthorId,
+		Author:   a.Author.Summary(),
+	}
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// Article fields that can be named in an update mask.
+const (
+	ArticleFieldTitle    = "title"
+	ArticleFieldContent  = "content"
+	ArticleFieldTags     = "tags"
+	ArticleFieldCategory = "category_id"
+)
+
+// ArticleOrderField is a column ListArticle can sort by.
+type ArticleOrderField string
+
+const (
+	ArticleOrderByCreatedAt ArticleOrderField = "created_at"
+	ArticleOrderByUpdatedAt ArticleOrderField = "updated_at"
+	ArticleOrderByLikeCount ArticleOrderField = "like_count"
+	// ArticleOrderByDeletedAt is only used to list the trash.
+	ArticleOrderByDeletedAt ArticleOrderField = "deleted_at"
+)
+
+// ArticleFilter narrows down which articles are listed or counted.
+type ArticleFilter struct {
+	Title         string        // substring of the title, ignored when empty
+	CreatedAfter  time.Time     // inclusive, ignored when zero
+	CreatedBefore time.Time     // exclusive, ignored when zero
+	Deleted       bool          // only articles in the trash instead of only live ones
+	Status        ArticleStatus // ignored when empty
+	Tag           string        // tag name, ignored when empty
+	CategoryId    int64         // ignored when zero
+	AuthorId      int64         // ignored when zero
+}
+
+// ArticleCursor is the keyset position of the last article of a page.
+type ArticleCursor struct {
+	Id        int64     `json:"i"`
+	CreatedAt time.Time `json:"c,omitempty"`
+	UpdatedAt time.Time `json:"u,omitempty"`
+	Like      int64     `json:"l,omitempty"`
+	DeletedAt time.Time `json:"x,omitempty"`
+}
+
+// ArticleListOption is what the repo needs to fetch one page.
+type ArticleListOption struct {
+	Filter  ArticleFilter
+	OrderBy ArticleOrderField
+	Desc    bool
+	After   *ArticleCursor // nil for the first page
+	Limit   int
+}
+
+// ListArticleQuery is a page request coming from the service layer.
+type ListArticleQuery struct {
+	ArticleFilter
+	PageSize  int
+	PageToken string
+	OrderBy   string // "<field> [asc|desc]", empty for "created_at desc"
+	WithTotal bool
+}
+
+// ArticlePage is one page of articles.
+type ArticlePage struct {
+	Articles      []*Article
+	NextPageToken string
+	Total         int64
+}
+
+// pageToken is the opaque content of a page token, it pins the ordering so a
+// token can't be replayed against a different sort.
+type pageToken struct {
+	OrderBy ArticleOrderField `json:"o"`
+	Desc    bool              `json:"d"`
+	ArticleCursor
+}
+
+func encodePageToken(t *pageToken) string {
+	bytes, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+func decodePageToken(s string) (*pageToken, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var t pageToken
+	if err = json.Unmarshal(bytes, &t); err != nil || t.Id <= 0 {
+		return nil, ErrInvalidPageToken
 	}
+	return &t, nil
+}
+
+// encodeInt64Token 用于只需一个数字定位的分页，如 revision 的 version、搜索的偏移
+func encodeInt64Token(v int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(v, 10)))
+}
+
+func decodeInt64Token(s string) (int64, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	v, err := strconv.ParseInt(string(bytes), 10, 64)
+	if err != nil || v <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+	return v, nil
+}
+
+func parseOrderBy(s string) (field ArticleOrderField, desc bool, err error) {
+	parts := strings.Fields(s)
+	if len(parts) == 0 {
+		return ArticleOrderByCreatedAt, true, nil
+	}
+	if len(parts) > 2 {
+		return "", false, ErrInvalidOrderBy
+	}
+	field = ArticleOrderField(parts[0])
+	switch field {
+	case ArticleOrderByCreatedAt, ArticleOrderByUpdatedAt, ArticleOrderByLikeCount:
+	default:
+		return "", false, ErrInvalidOrderBy
+	}
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return "", false, ErrInvalidOrderBy
+		}
+	}
+	return field, desc, nil
 }
 
 type ArticleRepo interface {
 	// db
-	ListArticle(ctx context.Context) ([]*Article, error)
+	ListArticle(ctx context.Context, opt *ArticleListOption) ([]*Article, error)
+	CountArticle(ctx context.Context, filter *ArticleFilter) (int64, error)
 	GetArticle(ctx context.Context, id int64) (*Article, error)
-	CreateArticle(ctx context.Context, article *Article) error
-	UpdateArticle(ctx context.Context, id int64, article *Article) error
+	// GetArticleAuthor returns the author id of the article, live or trashed.
+	GetArticleAuthor(ctx context.Context, id int64) (int64, error)
+	// CreateArticle stores the article, its tags, creating unknown ones, and
+	// its first revision; it fails with ErrCategoryNotFound for an unknown category.
+	CreateArticle(ctx context.Context, article *Article, author string) error
+	// UpdateArticle writes exactly the given fields, tags replacing every tag
+	// of the article, bumps the version and
+	// records the result as a revision by author; when article.Version is set
+	// it only updates that version and fails with ErrArticleConflict otherwise.
+	UpdateArticle(ctx context.Context, id int64, article *Article, fields []string, author string) error
+	// DeleteArticle moves the article to the trash.
 	DeleteArticle(ctx context.Context, id int64) error
+	// RestoreArticle takes the article out of the trash as a new version,
+	// recording author on its revision.
+	RestoreArticle(ctx context.Context, id int64, author string) error
+	// PurgeArticle removes the article, its revisions, tags, comments and redis
+	// state for good. A zero trashedBefore purges it live or trashed, otherwise
+	// only while it is still in the trash since before trashedBefore.
+	PurgeArticle(ctx context.Context, id int64, trashedBefore time.Time) error
+	// ListDeletedBefore returns ids of at most limit articles trashed before t.
+	ListDeletedBefore(ctx context.Context, t time.Time, limit int) ([]int64, error)
+	// TransitArticle applies the status change, failing with
+	// ErrInvalidStatusTransition when the article isn't in one of t.From.
+	TransitArticle(ctx context.Context, id int64, t *ArticleTransition) error
+	// ListDueArticles returns ids of at most limit scheduled articles due at t.
+	ListDueArticles(ctx context.Context, t time.Time, limit int) ([]int64, error)
+	// ListArticleRevisions returns at most limit revisions older than version
+	// before, 0 for the newest, without their content.
+	ListArticleRevisions(ctx context.Context, id int64, before int64, limit int) ([]*ArticleRevision, error)
+	GetArticleRevision(ctx context.Context, id int64, version int64) (*ArticleRevision, error)
 
 	// redis
 	GetArticleLike(ctx context.Context, id int64) (rv int64, err error)
-	IncArticleLike(ctx context.Context, id int64) error
+	// LikeArticle records userId's like once and returns the like count.
+	LikeArticle(ctx context.Context, id int64, userId int64) (int64, error)
+	// UnlikeArticle withdraws userId's like if any and returns the like count.
+	UnlikeArticle(ctx context.Context, id int64, userId int64) (int64, error)
+	// IncArticleView counts one read and returns the view count.
+	IncArticleView(ctx context.Context, id int64) (int64, error)
+	// FlushArticleLikes writes at most batch changed like counters back to the
+	// database and returns how many were taken.
+	FlushArticleLikes(ctx context.Context, batch int) (int, error)
+	// FlushArticleViews writes at most batch changed view counters back to the
+	// database and returns how many were taken.
+	FlushArticleViews(ctx context.Context, batch int) (int, error)
 }
 
 type ArticleUsecase struct {
-	repo ArticleRepo
+	repo     ArticleRepo
+	locker   Locker
+	searcher ArticleSearcher
+
+	// 可以查看所有作者未发布文章的角色，为 nil 表示未配置角色，所有登录用户都可以
+	reviewers map[string]bool
+
+	log *log.Helper
 }
 
-func NewArticleUsecase(repo ArticleRepo, logger log.Logger) *ArticleUsecase {
-	return &ArticleUsecase{repo: repo}
+func NewArticleUsecase(c *conf.Auth, repo ArticleRepo, locker Locker, searcher ArticleSearcher, logger log.Logger) *ArticleUsecase {
+	uc := &ArticleUsecase{repo: repo, locker: locker, searcher: searcher, log: log.NewHelper(logger)}
+	if roles := c.GetAuthz().GetRoles(); len(roles) > 0 {
+		uc.reviewers = make(map[string]bool)
+		for _, r := range roles {
+			if r.ReadUnpublished {
+				uc.reviewers[r.Name] = true
+			}
+		}
+	}
+	return uc
 }
 
-func (uc *ArticleUsecase) List(ctx context.Context) (ps []*Article, err error) {
-	ps, err = uc.repo.ListArticle(ctx)
-	if err != nil {
-		return
+// visibleFilter 未发布的文章只对作者本人和有 read_unpublished 的角色可见，
+// 其余调用方只能列出已发布的文章
+func (uc *ArticleUsecase) visibleFilter(ctx context.Context, filter ArticleFilter) ArticleFilter {
+	if filter.Status == "" || filter.Status == ArticleStatusPublished {
+		filter.Status = ArticleStatusPublished
+		return filter
+	}
+	c, ok := CallerFromContext(ctx)
+	switch {
+	case !ok:
+		filter.Status = ArticleStatusPublished
+	case uc.reviewers == nil || uc.reviewers[c.Role]:
+	case filter.AuthorId == 0 || filter.AuthorId == c.UserId:
+		filter.AuthorId = c.UserId
+	default:
+		filter.Status = ArticleStatusPublished
 	}
-	return
+	return filter
 }
 
-func (uc *ArticleUsecase) Get(ctx context.Context, id int64) (p *Article, err error) {
-	p, err = uc.repo.GetArticle(ctx, id)
+// trashFilter 回收站同样只对有 read_unpublished 的角色开放全部作者，其余调用方只能看到自己删除的文章
+func (uc *ArticleUsecase) trashFilter(ctx context.Context, filter ArticleFilter) (ArticleFilter, error) {
+	c, ok := CallerFromContext(ctx)
+	switch {
+	case !ok:
+		return filter, ErrUnauthenticated
+	case uc.reviewers == nil || uc.reviewers[c.Role]:
+	default:
+		filter.AuthorId = c.UserId
+	}
+	return filter, nil
+}
+
+func (uc *ArticleUsecase) List(ctx context.Context, q *ListArticleQuery) (*ArticlePage, error) {
+	field, desc, err := parseOrderBy(q.OrderBy)
 	if err != nil {
-		return
+		return nil, err
+	}
+	filter := q.ArticleFilter
+	if q.Deleted {
+		// 回收站固定按删除时间倒序
+		field, desc = ArticleOrderByDeletedAt, true
+		if filter, err = uc.trashFilter(ctx, filter); err != nil {
+			return nil, err
+		}
+	} else {
+		filter = uc.visibleFilter(ctx, filter)
+	}
+	size := q.PageSize
+	if size <= 0 {
+		size = defaultPageSize
 	}
-	err = uc.repo.IncArticleLike(ctx, id)
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+	opt := &ArticleListOption{
+		Filter:  filter,
+		OrderBy: field,
+		Desc:    desc,
+		Limit:   size + 1, // one extra row tells whether there is a next page
+	}
+	if q.PageToken != "" {
+		t, err := decodePageToken(q.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if t.OrderBy != field || t.Desc != desc {
+			return nil, ErrInvalidPageToken
+		}
+		opt.After = &t.ArticleCursor
+	}
+
+	ps, err := uc.repo.ListArticle(ctx, opt)
 	if err != nil {
-		return
+		return nil, err
+	}
+	page := &ArticlePage{Articles: ps}
+	if len(ps) > size {
+		page.Articles = ps[:size]
+		last := page.Articles[size-1]
+		page.NextPageToken = encodePageToken(&pageToken{
+			OrderBy: field,
+			Desc:    desc,
+			ArticleCursor: ArticleCursor{
+				Id:        last.Id,
+				CreatedAt: last.CreatedAt,
+				UpdatedAt: last.UpdatedAt,
+				Like:      last.Like,
+				DeletedAt: last.DeletedAt,
+			},
+		})
+	}
+	if q.WithTotal {
+		if page.Total, err = uc.repo.CountArticle(ctx, &filter); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// canRead 与 visibleFilter 规则相同：未发布的文章只有作者本人和有 read_unpublished 的角色可以读取
+func (uc *ArticleUsecase) canRead(ctx context.Context, a *Article) bool {
+	if a.Status == ArticleStatusPublished {
+		return true
+	}
+	c, ok := CallerFromContext(ctx)
+	if !ok {
+		return false
 	}
-	p.Like, err = uc.repo.GetArticleLike(ctx, id)
+	return uc.reviewers == nil || uc.reviewers[c.Role] || (c.UserId != 0 && a.AuthorId == c.UserId)
+}
+
+// getPublished 只返回已发布的文章，其余状态对读者等同于不存在
+func (uc *ArticleUsecase) getPublished(ctx context.Context, id int64) (*Article, error) {
+	p, err := uc.repo.GetArticle(ctx, id)
 	if err != nil {
-		return
+		return nil, err
 	}
-	return
+	if p.Status != ArticleStatusPublished {
+		return nil, ErrArticleNotFound
+	}
+	return p, nil
+}
+
+// AuthorOf returns the author id of the article in any status, also in the trash.
+func (uc *ArticleUsecase) AuthorOf(ctx context.Context, id int64) (int64, error) {
+	return uc.repo.GetArticleAuthor(ctx, id)
 }
 
+// Get returns an article the caller may read and counts reads of published
+// articles; unpublished ones are only visible to their author and reviewers.
+// The counters live in redis, when it is unavailable the read still succeeds
+// with the persisted counts.
+func (uc *ArticleUsecase) Get(ctx context.Context, id int64) (*Article, error) {
+	p, err := uc.repo.GetArticle(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.canRead(ctx, p) {
+		return nil, ErrArticleNotFound
+	}
+	if p.Status == ArticleStatusPublished {
+		if views, err := uc.repo.IncArticleView(ctx, id); err != nil {
+			uc.log.WithContext(ctx).Warnf("count view of article %d: %v", id, err)
+		} else {
+			p.Views = views
+		}
+	}
+	if like, err := uc.repo.GetArticleLike(ctx, id); err != nil {
+		uc.log.WithContext(ctx).Warnf("get likes of article %d: %v", id, err)
+	} else {
+		p.Like = like
+	}
+	return p, nil
+}
+
+// Like records a like of the caller on the article, liking twice is a no-op.
+func (uc *ArticleUsecase) Like(ctx context.Context, id int64) (int64, error) {
+	c, ok := CallerFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if _, err := uc.getPublished(ctx, id); err != nil {
+		return 0, err
+	}
+	return uc.repo.LikeArticle(ctx, id, c.UserId)
+}
+
+// Unlike withdraws the like of the caller on the article.
+func (uc *ArticleUsecase) Unlike(ctx context.Context, id int64) (int64, error) {
+	c, ok := CallerFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if _, err := uc.getPublished(ctx, id); err != nil {
+		return 0, err
+	}
+	return uc.repo.UnlikeArticle(ctx, id, c.UserId)
+}
+
+// FlushLikes drains every changed like counter into the database, batch by batch.
+func (uc *ArticleUsecase) FlushLikes(ctx context.Context, batch int) (int, error) {
+	return drain(ctx, batch, uc.repo.FlushArticleLikes)
+}
+
+// FlushViews drains every changed view counter into the database, batch by batch.
+func (uc *ArticleUsecase) FlushViews(ctx context.Context, batch int) (int, error) {
+	return drain(ctx, batch, uc.repo.FlushArticleViews)
+}
+
+func drain(ctx context.Context, batch int, flush func(ctx context.Context, batch int) (int, error)) (total int, err error) {
+	for {
+		n, err := flush(ctx, batch)
+		total += n
+		if err != nil || n < batch {
+			return total, err
+		}
+	}
+}
+
+// Create stores a new article as a draft authored by the caller.
 func (uc *ArticleUsecase) Create(ctx context.Context, article *Article) error {
-	return uc.repo.CreateArticle(ctx, article)
+	c, ok := CallerFromContext(ctx)
+	if !ok || c.UserId == 0 {
+		return ErrUnauthenticated
+	}
+	article.AuthorId = c.UserId
+	article.Status = ArticleStatusDraft
+	article.Tags = normalizeTags(article.Tags)
+	if err := uc.repo.CreateArticle(ctx, article, revisionAuthor(ctx)); err != nil {
+		return err
+	}
+	uc.reindex(ctx, article.Id)
+	return nil
+}
+
+// Update writes the fields named in mask, title and content when mask is empty,
+// and returns the article as stored afterwards; the caller is recorded on the revision.
+func (uc *ArticleUsecase) Update(ctx context.Context, id int64, article *Article, mask []string) (*Article, error) {
+	fields, err := articleUpdateFields(article, mask)
+	if err != nil {
+		return nil, err
+	}
+	article.Tags = normalizeTags(article.Tags)
+	if err = uc.repo.UpdateArticle(ctx, id, article, fields, revisionAuthor(ctx)); err != nil {
+		return nil, err
+	}
+	uc.reindex(ctx, id)
+	return uc.repo.GetArticle(ctx, id)
+}
+
+// revisionAuthor is the username recorded on revisions written by the request.
+func revisionAuthor(ctx context.Context) string {
+	if c, ok := CallerFromContext(ctx); ok {
+		return c.Username
+	}
+	return ""
+}
+
+// articleUpdateFields checks the mask and that every masked title or content
+// has a value, empty tags and category clear them.
+func articleUpdateFields(article *Article, mask []string) ([]string, error) {
+	if len(mask) == 0 {
+		mask = []string{ArticleFieldTitle, ArticleFieldContent}
+	}
+	fields := make([]string, 0, len(mask))
+	seen := make(map[string]bool, len(mask))
+	for _, f := range mask {
+		var empty bool
+		switch f {
+		case ArticleFieldTitle:
+			empty = article.Title == ""
+		case ArticleFieldContent:
+			empty = article.Content == ""
+		case ArticleFieldTags, ArticleFieldCategory:
+		default:
+			return nil, errors.BadRequest(ErrInvalidUpdateMask.Reason, fmt.Sprintf("unknown field %q in update_mask", f))
+		}
+		if empty {
+			return nil, errors.BadRequest(ErrInvalidUpdateMask.Reason, fmt.Sprintf("field %q is in update_mask but empty", f))
+		}
+		if !seen[f] {
+			seen[f] = true
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
 }
 
-func (uc *ArticleUsecase) Update(ctx context.Context, id int64, article *Article) error {
-	return uc.repo.UpdateArticle(ctx, id, article)
+// Delete moves the article to the trash, or removes it for good when purge is set.
+func (uc *ArticleUsecase) Delete(ctx context.Context, id int64, purge bool) error {
+	var err error
+	if purge {
+		err = uc.repo.PurgeArticle(ctx, id, time.Time{})
+	} else {
+		err = uc.repo.DeleteArticle(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+	uc.reindex(ctx, id)
+	return nil
 }
 
-func (uc *ArticleUsecase) Delete(ctx context.Context, id int64) error {
-	return uc.repo.DeleteArticle(ctx, id)
+// Restore takes the article out of the trash and returns it.
+func (uc *ArticleUsecase) Restore(ctx context.Context, id int64) (*Article, error) {
+	if err := uc.repo.RestoreArticle(ctx, id, revisionAuthor(ctx)); err != nil {
+		return nil, err
+	}
+	uc.reindex(ctx, id)
+	return uc.repo.GetArticle(ctx, id)
+}
+
+// trashLockKey 清理回收站的全局锁
+const trashLockKey = "article:purge"
+
+// PurgeTrash removes every article trashed before t, batch by batch, and
+// returns how many were removed. Only the replica holding the lock purges, the
+// others return 0 at once.
+func (uc *ArticleUsecase) PurgeTrash(ctx context.Context, t time.Time, batch int, lease time.Duration) (total int, err error) {
+	unlock, ok, err := uc.locker.TryLock(ctx, trashLockKey, lease)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer unlock()
+
+	for {
+		ids, err := uc.repo.ListDeletedBefore(ctx, t, batch)
+		if err != nil {
+			return total, err
+		}
+		for _, id := range ids {
+			switch err := uc.repo.PurgeArticle(ctx, id, t); {
+			case err == nil:
+				total++
+			case ErrArticleNotFound.Is(err):
+				// 期间被恢复或已被删除
+			default:
+				return total, err
+			}
+		}
+		if len(ids) < batch {
+			return total, nil
+		}
+	}
 }
 
 func (uc *ArticleUsecase) CastJson(ctx context.Context, article *Article) (string, error) {
@@ -0,0 +1,107 @@
+package biz
+
+import (
+	pb "agdemo/api/blog/v1"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrRevisionNotFound is returned when the article has no revision with the requested version.
+var ErrRevisionNotFound = errors.NotFound(pb.ErrorReason_REVISION_NOT_FOUND.String(), "article revision not found")
+
+// ArticleRevision is the immutable snapshot an article write produced.
+type ArticleRevision struct {
+	ArticleId int64
+	Version   int64
+	Title     string
+	Content   string
+	Author    string
+	CreatedAt time.Time
+}
+
+func (r *ArticleRevision) ToProto() *pb.ArticleRevision {
+	return &pb.ArticleRevision{
+		ArticleId: r.ArticleId,
+		Version:   r.Version,
+		Title:     r.Title,
+		Content:   r.Content,
+		Author:    r.Author,
+		CreatedAt: timestamp(r.CreatedAt),
+	}
+}
+
+// text 把快照渲染为标题、空行、正文，用于行级 diff
+func (r *ArticleRevision) text() string {
+	return r.Title + "\n\n" + r.Content
+}
+
+// ArticleRevisionPage is one page of revisions, newest first.
+type ArticleRevisionPage struct {
+	Revisions     []*ArticleRevision
+	NextPageToken string
+}
+
+// ListRevisions pages through the revisions of an article, without their content.
+func (uc *ArticleUsecase) ListRevisions(ctx context.Context, id int64, pageSize int, pageToken string) (*ArticleRevisionPage, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	var before int64
+	if pageToken != "" {
+		// revision 分页按 version 倒序，token 即上一页最后一个 version
+		v, err := decodeInt64Token(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		before = v
+	}
+	rs, err := uc.repo.ListArticleRevisions(ctx, id, before, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &ArticleRevisionPage{Revisions: rs}
+	if len(rs) > pageSize {
+		page.Revisions = rs[:pageSize]
+		page.NextPageToken = encodeInt64Token(page.Revisions[pageSize-1].Version)
+	}
+	return page, nil
+}
+
+func (uc *ArticleUsecase) GetRevision(ctx context.Context, id, version int64) (*ArticleRevision, error) {
+	return uc.repo.GetArticleRevision(ctx, id, version)
+}
+
+// DiffRevisions returns the unified diff going from one revision to another.
+func (uc *ArticleUsecase) DiffRevisions(ctx context.Context, id, from, to int64) (string, error) {
+	a, err := uc.repo.GetArticleRevision(ctx, id, from)
+	if err != nil {
+		return "", err
+	}
+	b, err := uc.repo.GetArticleRevision(ctx, id, to)
+	if err != nil {
+		return "", err
+	}
+	return unifiedDiff(fmt.Sprintf("article/%d@%d", id, from), fmt.Sprintf("article/%d@%d", id, to), a.text(), b.text()), nil
+}
+
+// Rollback writes the title and content of an earlier revision back as a new
+// version; version is the expected current version, 0 skips the check.
+func (uc *ArticleUsecase) Rollback(ctx context.Context, id, toVersion, version int64) (*Article, error) {
+	rev, err := uc.repo.GetArticleRevision(ctx, id, toVersion)
+	if err != nil {
+		return nil, err
+	}
+	article := &Article{Title: rev.Title, Content: rev.Content, Version: version}
+	fields := []string{ArticleFieldTitle, ArticleFieldContent}
+	if err = uc.repo.UpdateArticle(ctx, id, article, fields, revisionAuthor(ctx)); err != nil {
+		return nil, err
+	}
+	uc.reindex(ctx, id)
+	return uc.repo.GetArticle(ctx, id)
+}
@@ -0,0 +1,234 @@
+package biz
+
+import (
+	pb "agdemo/api/blog/v1"
+	"context"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrInvalidSearchQuery is returned when a query has nothing to match on.
+var ErrInvalidSearchQuery = errors.BadRequest(pb.ErrorReason_INVALID_SEARCH_QUERY.String(), "search query has no searchable term")
+
+// snippetWidth 正文摘要的大致长度（字符数）
+const snippetWidth = 120
+
+// SearchQuery is one page of a full text search.
+type SearchQuery struct {
+	Query  string   // as typed by the user
+	Terms  []string // SearchTokens of Query, deduplicated
+	Offset int
+	Limit  int
+}
+
+// SearchHit is an article id matching a query, with its relevance.
+type SearchHit struct {
+	Id    int64
+	Score float64
+}
+
+// ArticleSearcher is a full text index over the title and content of published articles.
+type ArticleSearcher interface {
+	// Index adds or replaces the article in the index.
+	Index(ctx context.Context, article *Article) error
+	// Remove drops the article from the index, unknown ids are ignored.
+	Remove(ctx context.Context, id int64) error
+	// Search returns the hits of a query, most relevant first.
+	Search(ctx context.Context, q *SearchQuery) ([]*SearchHit, error)
+	// Refresh catches up with writes made by other replicas; backends kept in
+	// sync by the database do nothing.
+	Refresh(ctx context.Context) error
+}
+
+// SearchResult is an article matching a query with highlighted snippets.
+type SearchResult struct {
+	Article        *Article
+	Score          float64
+	TitleSnippet   string
+	ContentSnippet string
+}
+
+type SearchPage struct {
+	Results       []*SearchResult
+	NextPageToken string
+}
+
+// SearchTokens splits text into lower-cased terms: runs of letters and digits,
+// and every Han character on its own, as CJK text has no spaces between words.
+func SearchTokens(s string) []string {
+	var tokens []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	for _, r := range s {
+		r = unicode.ToLower(r)
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !unicode.Is(unicode.Han, r)
+}
+
+// matchRanges 返回 terms 在 rs 中按词边界出现的 [start, end) 位置，互不重叠，相邻的合并
+func matchRanges(rs []rune, terms []string) [][2]int {
+	lower := make([]rune, len(rs))
+	for i, r := range rs {
+		lower[i] = unicode.ToLower(r)
+	}
+	var ranges [][2]int
+	for i := 0; i < len(lower); {
+		end := -1
+		if i == 0 || !isWordRune(lower[i-1]) || !isWordRune(lower[i]) {
+			for _, t := range terms {
+				tr := []rune(t)
+				j := i + len(tr)
+				if j > len(lower) || j <= end || string(lower[i:j]) != t {
+					continue
+				}
+				if isWordRune(tr[len(tr)-1]) && j < len(lower) && isWordRune(lower[j]) {
+					continue
+				}
+				end = j
+			}
+		}
+		if end < 0 {
+			i++
+			continue
+		}
+		if n := len(ranges); n > 0 && ranges[n-1][1] == i {
+			ranges[n-1][1] = end
+		} else {
+			ranges = append(ranges, [2]int{i, end})
+		}
+		i = end
+	}
+	return ranges
+}
+
+// highlight HTML-escapes s and wraps the matched terms in <em>; with width > 0
+// only a window of about width characters around the first match is kept.
+func highlight(s string, terms []string, width int) string {
+	rs := []rune(s)
+	ranges := matchRanges(rs, terms)
+	start, end := 0, len(rs)
+	if width > 0 && len(rs) > width {
+		if len(ranges) > 0 {
+			start = ranges[0][0] - width/4
+		}
+		if start < 0 {
+			start = 0
+		}
+		end = start + width
+		if end > len(rs) {
+			end, start = len(rs), len(rs)-width
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range ranges {
+		if m[1] <= start || m[0] >= end {
+			continue
+		}
+		from, to := max(m[0], start), min(m[1], end)
+		b.WriteString(html.EscapeString(string(rs[pos:from])))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(string(rs[from:to])))
+		b.WriteString("</em>")
+		pos = to
+	}
+	b.WriteString(html.EscapeString(string(rs[pos:end])))
+	if end < len(rs) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// Search finds published articles matching query, most relevant first.
+func (uc *ArticleUsecase) Search(ctx context.Context, query string, pageSize int, pageToken string) (*SearchPage, error) {
+	terms := normalizeTags(SearchTokens(query))
+	if len(terms) == 0 {
+		return nil, ErrInvalidSearchQuery
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	var offset int64
+	if pageToken != "" {
+		v, err := decodeInt64Token(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		offset = v
+	}
+	hits, err := uc.searcher.Search(ctx, &SearchQuery{
+		Query:  query,
+		Terms:  terms,
+		Offset: int(offset),
+		Limit:  pageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	page := &SearchPage{}
+	if len(hits) > pageSize {
+		hits = hits[:pageSize]
+		page.NextPageToken = encodeInt64Token(offset + int64(pageSize))
+	}
+	for _, h := range hits {
+		a, err := uc.getPublished(ctx, h.Id)
+		if ErrArticleNotFound.Is(err) {
+			continue // 索引尚未跟上删除或下线
+		}
+		if err != nil {
+			return nil, err
+		}
+		page.Results = append(page.Results, &SearchResult{
+			Article:        a,
+			Score:          h.Score,
+			TitleSnippet:   highlight(a.Title, terms, 0),
+			ContentSnippet: highlight(a.Content, terms, snippetWidth),
+		})
+	}
+	return page, nil
+}
+
+// RefreshSearchIndex catches the search index up with writes made by other replicas.
+func (uc *ArticleUsecase) RefreshSearchIndex(ctx context.Context) error {
+	return uc.searcher.Refresh(ctx)
+}
+
+// reindex 写操作后同步搜索索引：已发布的文章写入索引，其余移出；索引失败只记录日志
+func (uc *ArticleUsecase) reindex(ctx context.Context, id int64) {
+	a, err := uc.repo.GetArticle(ctx, id)
+	switch {
+	case err == nil && a.Status == ArticleStatusPublished:
+		err = uc.searcher.Index(ctx, a)
+	case err == nil || ErrArticleNotFound.Is(err):
+		err = uc.searcher.Remove(ctx, id)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("reindex article %d error: %v", id, err)
+	}
+}
@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchTokens(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"  ,.! ", nil},
+		{"Hello, World", []string{"hello", "world"}},
+		{"Go1.22 release", []string{"go1", "22", "release"}},
+		{"ÄBC-def", []string{"äbc", "def"}},
+		{"你好world", []string{"你", "好", "world"}},
+		{"Go语言", []string{"go", "语", "言"}},
+	}
+	for _, tt := range tests {
+		if got := SearchTokens(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTokens(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMatchRanges(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		terms []string
+		want  [][2]int
+	}{
+		{"word boundaries", "go gopher go", []string{"go"}, [][2]int{{0, 2}, {10, 12}}},
+		{"case insensitive", "GoLang", []string{"golang"}, [][2]int{{0, 6}}},
+		{"longest term wins", "golang", []string{"go", "golang"}, [][2]int{{0, 6}}},
+		{"adjacent merged", "世界", []string{"世", "界"}, [][2]int{{0, 2}}},
+		{"han inside latin", "abc世", []string{"世"}, [][2]int{{3, 4}}},
+		{"separated by punctuation", "go-lang", []string{"go", "lang"}, [][2]int{{0, 2}, {3, 7}}},
+		{"no match", "rust", []string{"go"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchRanges([]rune(tt.s), tt.terms); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("matchRanges(%q, %q) = %v, want %v", tt.s, tt.terms, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	long := ""
+	for i := 0; i < 50; i++ {
+		long += "x "
+	}
+	long += "go"
+	for i := 0; i < 50; i++ {
+		long += " y"
+	}
+	tests := []struct {
+		name  string
+		s     string
+		terms []string
+		width int
+		want  string
+	}{
+		{"escapes html", "<b>Go</b>", []string{"go"}, 0, "&lt;b&gt;<em>Go</em>&lt;/b&gt;"},
+		{"whole text", "learn go and more go", []string{"go"}, 0, "learn <em>go</em> and more <em>go</em>"},
+		{"window around match", long, []string{"go"}, 20, "… x x <em>go</em> y y y y y y …"},
+		{"window without match", "abcdefghij", []string{"z"}, 4, "abcd…"},
+		{"window at the end", "aaaa bbbb go", []string{"go"}, 6, "…bbb <em>go</em>"},
+		{"short text kept", "go", []string{"go"}, 10, "<em>go</em>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlight(tt.s, tt.terms, tt.width); got != tt.want {
+				t.Fatalf("highlight = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
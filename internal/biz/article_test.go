@@ -0,0 +1,65 @@
+package biz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseOrderBy(t *testing.T) {
+	tests := []struct {
+		in    string
+		field ArticleOrderField
+		desc  bool
+		err   bool
+	}{
+		{"", ArticleOrderByCreatedAt, true, false},
+		{"created_at", ArticleOrderByCreatedAt, false, false},
+		{"updated_at desc", ArticleOrderByUpdatedAt, true, false},
+		{"like_count asc", ArticleOrderByLikeCount, false, false},
+		{"title", "", false, true},
+		{"created_at up", "", false, true},
+		{"created_at desc extra", "", false, true},
+	}
+	for _, tt := range tests {
+		field, desc, err := parseOrderBy(tt.in)
+		if (err != nil) != tt.err {
+			t.Errorf("parseOrderBy(%q) err = %v", tt.in, err)
+			continue
+		}
+		if field != tt.field || desc != tt.desc {
+			t.Errorf("parseOrderBy(%q) = %s %v, want %s %v", tt.in, field, desc, tt.field, tt.desc)
+		}
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	in := &pageToken{
+		OrderBy:       ArticleOrderByLikeCount,
+		Desc:          true,
+		ArticleCursor: ArticleCursor{Id: 7, Like: 3, CreatedAt: time.Unix(100, 0).UTC()},
+	}
+	out, err := decodePageToken(encodePageToken(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.OrderBy != in.OrderBy || out.Desc != in.Desc || out.Id != 7 || out.Like != 3 || !out.CreatedAt.Equal(in.CreatedAt) {
+		t.Fatalf("decodePageToken = %+v", out)
+	}
+	for _, s := range []string{"!!", "bm90IGpzb24", encodePageToken(&pageToken{})} {
+		if _, err := decodePageToken(s); !ErrInvalidPageToken.Is(err) {
+			t.Errorf("decodePageToken(%q) err = %v", s, err)
+		}
+	}
+}
+
+func TestInt64Token(t *testing.T) {
+	v, err := decodeInt64Token(encodeInt64Token(42))
+	if err != nil || v != 42 {
+		t.Fatalf("decodeInt64Token = %d %v", v, err)
+	}
+	for _, s := range []string{"!!", encodeInt64Token(0), encodeInt64Token(-1)} {
+		if _, err := decodeInt64Token(s); !ErrInvalidPageToken.Is(err) {
+			t.Errorf("decodeInt64Token(%q) err = %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package biz
+
+import (
+	pb "agdemo/api/blog/v1"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	// ErrInvalidStatusTransition is returned when the workflow doesn't allow the move from the current status.
+	ErrInvalidStatusTransition = errors.Conflict(pb.ErrorReason_INVALID_STATUS_TRANSITION.String(), "invalid article status transition")
+	// ErrInvalidPublishAt is returned when an article is scheduled for a time that has passed.
+	ErrInvalidPublishAt = errors.BadRequest(pb.ErrorReason_INVALID_PUBLISH_AT.String(), "publish_at must be in the future")
+)
+
+// ArticleStatus is where an article is in the publishing workflow.
+type ArticleStatus string
+
+const (
+	ArticleStatusDraft     ArticleStatus = "draft"
+	ArticleStatusInReview  ArticleStatus = "in_review"
+	ArticleStatusScheduled ArticleStatus = "scheduled"
+	ArticleStatusPublished ArticleStatus = "published"
+	ArticleStatusArchived  ArticleStatus = "archived"
+)
+
+var articleStatusProto = map[ArticleStatus]pb.ArticleStatus{
+	ArticleStatusDraft:     pb.ArticleStatus_ARTICLE_STATUS_DRAFT,
+	ArticleStatusInReview:  pb.ArticleStatus_ARTICLE_STATUS_IN_REVIEW,
+	ArticleStatusScheduled: pb.ArticleStatus_ARTICLE_STATUS_SCHEDULED,
+	ArticleStatusPublished: pb.ArticleStatus_ARTICLE_STATUS_PUBLISHED,
+	ArticleStatusArchived:  pb.ArticleStatus_ARTICLE_STATUS_ARCHIVED,
+}
+
+func (s ArticleStatus) ToProto() pb.ArticleStatus {
+	return articleStatusProto[s]
+}
+
+// ArticleStatusFromProto maps the proto enum, unspecified becomes "".
+func ArticleStatusFromProto(s pb.ArticleStatus) ArticleStatus {
+	for k, v := range articleStatusProto {
+		if v == s {
+			return k
+		}
+	}
+	return ""
+}
+
+// articleTransitions 工作流状态机：目标状态 -> 允许的来源状态
+var articleTransitions = map[ArticleStatus][]ArticleStatus{
+	ArticleStatusDraft:     {ArticleStatusInReview, ArticleStatusScheduled, ArticleStatusPublished, ArticleStatusArchived},
+	ArticleStatusInReview:  {ArticleStatusDraft},
+	ArticleStatusScheduled: {ArticleStatusDraft, ArticleStatusInReview},
+	ArticleStatusPublished: {ArticleStatusDraft, ArticleStatusInReview, ArticleStatusScheduled},
+	ArticleStatusArchived:  {ArticleStatusDraft, ArticleStatusInReview, ArticleStatusScheduled, ArticleStatusPublished},
+}
+
+// ArticleTransition is a status change that only applies while the article is
+// in one of From, so concurrent moves can't skip the state machine. It bumps
+// the version like any other write.
+type ArticleTransition struct {
+	From      []ArticleStatus
+	To        ArticleStatus
+	PublishAt time.Time // when to publish, for ArticleStatusScheduled
+	At        time.Time // when the move happens, recorded as the publish time
+	Due       bool      // additionally require publish_at <= At
+	Author    string    // recorded on the revision of the new version
+}
+
+// Locker hands out leases that only one replica holds at a time.
+type Locker interface {
+	// TryLock returns ok false when the lease is held elsewhere; unlock
+	// releases it early and is a no-op once the lease has expired.
+	TryLock(ctx context.Context, key string, ttl time.Duration) (unlock func(), ok bool, err error)
+}
+
+// publishLockKey 定时发布的全局锁
+const publishLockKey = "article:publish"
+
+func (uc *ArticleUsecase) transit(ctx context.Context, id int64, to ArticleStatus, publishAt time.Time) (*Article, error) {
+	t := &ArticleTransition{
+		From:      articleTransitions[to],
+		To:        to,
+		PublishAt: publishAt,
+		At:        time.Now(),
+		Author:    revisionAuthor(ctx),
+	}
+	if err := uc.repo.TransitArticle(ctx, id, t); err != nil {
+		return nil, err
+	}
+	uc.reindex(ctx, id)
+	return uc.repo.GetArticle(ctx, id)
+}
+
+// Submit sends a draft to review.
+func (uc *ArticleUsecase) Submit(ctx context.Context, id int64) (*Article, error) {
+	return uc.transit(ctx, id, ArticleStatusInReview, time.Time{})
+}
+
+// Schedule publishes the article automatically at publishAt.
+func (uc *ArticleUsecase) Schedule(ctx context.Context, id int64, publishAt time.Time) (*Article, error) {
+	if !publishAt.After(time.Now()) {
+		return nil, ErrInvalidPublishAt
+	}
+	return uc.transit(ctx, id, ArticleStatusScheduled, publishAt)
+}
+
+// Publish makes the article public right away.
+func (uc *ArticleUsecase) Publish(ctx context.Context, id int64) (*Article, error) {
+	return uc.transit(ctx, id, ArticleStatusPublished, time.Time{})
+}
+
+// Unpublish takes the article back to draft, cancelling its schedule if any.
+func (uc *ArticleUsecase) Unpublish(ctx context.Context, id int64) (*Article, error) {
+	return uc.transit(ctx, id, ArticleStatusDraft, time.Time{})
+}
+
+func (uc *ArticleUsecase) Archive(ctx context.Context, id int64) (*Article, error) {
+	return uc.transit(ctx, id, ArticleStatusArchived, time.Time{})
+}
+
+// PublishDue publishes every scheduled article whose time has come and returns
+// how many were published. Only the replica holding the lock does the work, and
+// each publish is conditional on the article still being scheduled, so an
+// article is published once even if the lease runs out mid-way.
+func (uc *ArticleUsecase) PublishDue(ctx context.Context, batch int, lease time.Duration) (total int, err error) {
+	unlock, ok, err := uc.locker.TryLock(ctx, publishLockKey, lease)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer unlock()
+
+	now := time.Now()
+	for {
+		ids, err := uc.repo.ListDueArticles(ctx, now, batch)
+		if err != nil {
+			return total, err
+		}
+		for _, id := range ids {
+			err := uc.repo.TransitArticle(ctx, id, &ArticleTransition{
+				From: []ArticleStatus{ArticleStatusScheduled},
+				To:   ArticleStatusPublished,
+				At:   now,
+				Due:  true,
+			})
+			switch {
+			case err == nil:
+				uc.reindex(ctx, id)
+				total++
+			case ErrInvalidStatusTransition.Is(err), ErrArticleNotFound.Is(err):
+				// 期间被取消、改期或删除
+			default:
+				return total, err
+			}
+		}
+		if len(ids) < batch {
+			return total, nil
+		}
+	}
+}
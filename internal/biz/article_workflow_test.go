@@ -0,0 +1,51 @@
+package biz
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestVisibleFilter(t *testing.T) {
+	auth := &conf.Auth{Authz: &conf.Auth_Authz{Roles: []*conf.Auth_Authz_Role{
+		{Name: "editor", ReadUnpublished: true},
+		{Name: "author"},
+	}}}
+	uc := NewArticleUsecase(auth, nil, nil, nil, log.DefaultLogger)
+	author := NewCallerContext(context.Background(), &Caller{UserId: 1, Role: "author"})
+	editor := NewCallerContext(context.Background(), &Caller{UserId: 2, Role: "editor"})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		in       ArticleFilter
+		status   ArticleStatus
+		authorId int64
+	}{
+		{"default", context.Background(), ArticleFilter{}, ArticleStatusPublished, 0},
+		{"anonymous published", context.Background(), ArticleFilter{Status: ArticleStatusPublished}, ArticleStatusPublished, 0},
+		{"anonymous draft", context.Background(), ArticleFilter{Status: ArticleStatusDraft}, ArticleStatusPublished, 0},
+		{"author own drafts", author, ArticleFilter{Status: ArticleStatusDraft}, ArticleStatusDraft, 1},
+		{"author own drafts by id", author, ArticleFilter{Status: ArticleStatusInReview, AuthorId: 1}, ArticleStatusInReview, 1},
+		{"author other's drafts", author, ArticleFilter{Status: ArticleStatusScheduled, AuthorId: 3}, ArticleStatusPublished, 3},
+		{"editor any drafts", editor, ArticleFilter{Status: ArticleStatusInReview}, ArticleStatusInReview, 0},
+		{"editor other's drafts", editor, ArticleFilter{Status: ArticleStatusDraft, AuthorId: 3}, ArticleStatusDraft, 3},
+	}
+	for _, tt := range tests {
+		got := uc.visibleFilter(tt.ctx, tt.in)
+		if got.Status != tt.status || got.AuthorId != tt.authorId {
+			t.Errorf("%s: status %s author %d, want %s %d", tt.name, got.Status, got.AuthorId, tt.status, tt.authorId)
+		}
+	}
+
+	// 未配置角色时不限制登录用户
+	open := NewArticleUsecase(&conf.Auth{}, nil, nil, nil, log.DefaultLogger)
+	if got := open.visibleFilter(author, ArticleFilter{Status: ArticleStatusDraft}); got.Status != ArticleStatusDraft || got.AuthorId != 0 {
+		t.Errorf("unconfigured roles: %+v", got)
+	}
+	if got := open.visibleFilter(context.Background(), ArticleFilter{Status: ArticleStatusDraft}); got.Status != ArticleStatusPublished {
+		t.Errorf("unconfigured roles, anonymous: %+v", got)
+	}
+}
@@ -0,0 +1,281 @@
+package biz
+
+import (
+	pb "agdemo/api/blog/v1"
+	"agdemo/internal/conf"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrCommentNotFound is comment not found.
+	ErrCommentNotFound = errors.NotFound(pb.ErrorReason_COMMENT_NOT_FOUND.String(), "comment not found")
+	// ErrCommentTooDeep is returned when a reply would nest deeper than allowed.
+	ErrCommentTooDeep = errors.BadRequest(pb.ErrorReason_COMMENT_TOO_DEEP.String(), "comment nested too deep")
+)
+
+const (
+	defaultCommentMaxDepth   = 3
+	defaultCommentMaxReplies = 100
+)
+
+// CommentStatus is the moderation state of a comment.
+type CommentStatus string
+
+const (
+	CommentStatusPending  CommentStatus = "pending"
+	CommentStatusApproved CommentStatus = "approved"
+	CommentStatusRejected CommentStatus = "rejected"
+)
+
+var commentStatusProto = map[CommentStatus]pb.CommentStatus{
+	CommentStatusPending:  pb.CommentStatus_COMMENT_STATUS_PENDING,
+	CommentStatusApproved: pb.CommentStatus_COMMENT_STATUS_APPROVED,
+	CommentStatusRejected: pb.CommentStatus_COMMENT_STATUS_REJECTED,
+}
+
+func (s CommentStatus) ToProto() pb.CommentStatus {
+	return commentStatusProto[s]
+}
+
+// CommentStatusFromProto maps the proto enum, unspecified becomes "".
+func CommentStatusFromProto(s pb.CommentStatus) CommentStatus {
+	for k, v := range commentStatusProto {
+		if v == s {
+			return k
+		}
+	}
+	return ""
+}
+
+type Comment struct {
+	Id          int64
+	ArticleId   int64
+	ParentId    int64 // 0 for a top level comment
+	RootId      int64 // top level comment of the thread, 0 for a top level comment
+	Depth       int   // 0 for a top level comment
+	Author      string
+	Content     string
+	Status      CommentStatus
+	CreatedAt   time.Time
+	Replies     []*Comment // approved replies, oldest first
+	MoreReplies bool       // replies were cut at the cap, only set on a top level comment
+}
+
+func (c *Comment) ToProto() *pb.Comment {
+	res := &pb.Comment{
+		Id:          c.Id,
+		ArticleId:   c.ArticleId,
+		ParentId:    c.ParentId,
+		Depth:       int32(c.Depth),
+		Author:      c.Author,
+		Content:     c.Content,
+		Status:      c.Status.ToProto(),
+		CreatedAt:   timestamp(c.CreatedAt),
+		MoreReplies: c.MoreReplies,
+	}
+	for _, r := range c.Replies {
+		res.Replies = append(res.Replies, r.ToProto())
+	}
+	return res
+}
+
+type CommentPage struct {
+	Comments      []*Comment
+	NextPageToken string
+}
+
+type CommentRepo interface {
+	// CreateComment stores the comment under its parent, if any.
+	CreateComment(ctx context.Context, comment *Comment) error
+	GetComment(ctx context.Context, id int64) (*Comment, error)
+	// ListRootComments returns at most limit approved top level comments of
+	// the article older than id before, 0 for the newest, newest first.
+	ListRootComments(ctx context.Context, articleId int64, before int64, limit int) ([]*Comment, error)
+	// ListReplies returns the oldest limit approved replies under each of the
+	// top level comments, oldest first.
+	ListReplies(ctx context.Context, rootIds []int64, limit int) ([]*Comment, error)
+	// ListPendingComments returns at most limit pending comments after id after, oldest first.
+	ListPendingComments(ctx context.Context, after int64, limit int) ([]*Comment, error)
+	// ModerateComment moves the comment from one status to another and keeps
+	// the comment count of the article in step; it fails with
+	// ErrCommentNotFound when the comment is gone or no longer in from.
+	ModerateComment(ctx context.Context, id int64, from, to CommentStatus) error
+	// DeleteComment removes the comment and every reply under it.
+	DeleteComment(ctx context.Context, id int64) error
+}
+
+type CommentUsecase struct {
+	repo       CommentRepo
+	articles   ArticleRepo
+	maxDepth   int
+	maxReplies int
+	log        *log.Helper
+}
+
+func NewCommentUsecase(c *conf.Data, repo CommentRepo, articles ArticleRepo, logger log.Logger) *CommentUsecase {
+	uc := &CommentUsecase{
+		repo:       repo,
+		articles:   articles,
+		maxDepth:   defaultCommentMaxDepth,
+		maxReplies: defaultCommentMaxReplies,
+		log:        log.NewHelper(logger),
+	}
+	if c.Comment != nil && c.Comment.MaxDepth > 0 {
+		uc.maxDepth = int(c.Comment.MaxDepth)
+	}
+	if c.Comment != nil && c.Comment.MaxReplies > 0 {
+		uc.maxReplies = int(c.Comment.MaxReplies)
+	}
+	return uc
+}
+
+// checkArticle 只能评论和查看已发布文章的评论
+func (uc *CommentUsecase) checkArticle(ctx context.Context, id int64) error {
+	a, err := uc.articles.GetArticle(ctx, id)
+	if err != nil {
+		return err
+	}
+	if a.Status != ArticleStatusPublished {
+		return ErrArticleNotFound
+	}
+	return nil
+}
+
+// Create stores a comment pending moderation, replies must go under an
+// approved comment of the same article. Signed in callers always comment
+// under their own username.
+func (uc *CommentUsecase) Create(ctx context.Context, c *Comment) error {
+	if err := uc.checkArticle(ctx, c.ArticleId); err != nil {
+		return err
+	}
+	c.Depth = 0
+	if c.ParentId > 0 {
+		parent, err := uc.repo.GetComment(ctx, c.ParentId)
+		if err != nil {
+			return err
+		}
+		if parent.ArticleId != c.ArticleId || parent.Status != CommentStatusApproved {
+			return ErrCommentNotFound
+		}
+		c.Depth = parent.Depth + 1
+		if c.Depth > uc.maxDepth {
+			return ErrCommentTooDeep
+		}
+	}
+	// 登录用户以自己的用户名发表，忽略请求中的 author，避免冒用他人的名字
+	if caller, ok := CallerFromContext(ctx); ok && caller.Username != "" {
+		c.Author = caller.Username
+	}
+	c.Status = CommentStatusPending
+	return uc.repo.CreateComment(ctx, c)
+}
+
+// List pages through the approved top level comments of an article, each with
+// the tree of its oldest approved replies.
+func (uc *CommentUsecase) List(ctx context.Context, articleId int64, pageSize int, pageToken string) (*CommentPage, error) {
+	if err := uc.checkArticle(ctx, articleId); err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	var before int64
+	if pageToken != "" {
+		v, err := decodeInt64Token(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		before = v
+	}
+	roots, err := uc.repo.ListRootComments(ctx, articleId, before, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &CommentPage{Comments: roots}
+	if len(roots) > pageSize {
+		page.Comments = roots[:pageSize]
+		page.NextPageToken = encodeInt64Token(page.Comments[pageSize-1].Id)
+	}
+	if len(page.Comments) == 0 {
+		return page, nil
+	}
+
+	byId := make(map[int64]*Comment, len(page.Comments))
+	rootIds := make([]int64, 0, len(page.Comments))
+	for _, c := range page.Comments {
+		byId[c.Id] = c
+		rootIds = append(rootIds, c.Id)
+	}
+	// 多取一条用来判断是否还有更多回复
+	replies, err := uc.repo.ListReplies(ctx, rootIds, uc.maxReplies+1)
+	if err != nil {
+		return nil, err
+	}
+	// 回复按 id 升序，父评论总在子评论之前；父评论未通过审核时整棵子树不展示
+	counts := make(map[int64]int, len(rootIds))
+	for _, r := range replies {
+		if counts[r.RootId]++; counts[r.RootId] > uc.maxReplies {
+			if root, ok := byId[r.RootId]; ok {
+				root.MoreReplies = true
+			}
+			continue
+		}
+		parent, ok := byId[r.ParentId]
+		if !ok {
+			continue
+		}
+		parent.Replies = append(parent.Replies, r)
+		byId[r.Id] = r
+	}
+	return page, nil
+}
+
+// ListPending pages through the moderation queue, oldest first.
+func (uc *CommentUsecase) ListPending(ctx context.Context, pageSize int, pageToken string) (*CommentPage, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	var after int64
+	if pageToken != "" {
+		v, err := decodeInt64Token(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		after = v
+	}
+	list, err := uc.repo.ListPendingComments(ctx, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+	page := &CommentPage{Comments: list}
+	if len(list) > pageSize {
+		page.Comments = list[:pageSize]
+		page.NextPageToken = encodeInt64Token(page.Comments[pageSize-1].Id)
+	}
+	return page, nil
+}
+
+// Moderate approves or rejects a comment and returns it.
+func (uc *CommentUsecase) Moderate(ctx context.Context, id int64, status CommentStatus) (*Comment, error) {
+	c, err := uc.repo.GetComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status == status {
+		return c, nil
+	}
+	if err = uc.repo.ModerateComment(ctx, id, c.Status, status); err != nil {
+		return nil, err
+	}
+	c.Status = status
+	return c, nil
+}
+
+// Delete removes the comment and every reply under it.
+func (uc *CommentUsecase) Delete(ctx context.Context, id int64) error {
+	return uc.repo.DeleteComment(ctx, id)
+}
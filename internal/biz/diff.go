@@ -0,0 +1,147 @@
+package biz
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is how many unchanged lines surround each hunk.
+const diffContext = 3
+
+// diffOp is one line of an edit script: ' ' kept, '-' removed from a, '+' added from b.
+type diffOp struct {
+	kind byte
+	line string
+	a, b int // lines of a and b before this one
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes a shortest edit script from a to b with Myers' algorithm.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	max := n + m
+	off := max + 1
+	v := make([]int, 2*max+3)
+	// trace[d] holds v[-d-1..d+1] as it was before step d, enough to walk back
+	var trace [][]int
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v[off-d-1:off+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrack(a, b []string, trace [][]int) []diffOp {
+	var ops []diffOp
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v, off := trace[d], d+1
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[off+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			ops = append(ops, diffOp{kind: ' ', line: a[x], a: x, b: y})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			ops = append(ops, diffOp{kind: '+', line: b[prevY], a: x, b: prevY})
+		} else {
+			ops = append(ops, diffOp{kind: '-', line: a[prevX], a: prevX, b: y})
+		}
+		x, y = prevX, prevY
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// unifiedDiff renders the line diff of a and b in unified format, it is empty
+// when both are equal.
+func unifiedDiff(nameA, nameB, a, b string) string {
+	ops := diffLines(splitLines(a), splitLines(b))
+	var sb strings.Builder
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// 合并相距不超过 2*diffContext 行的改动为一个 hunk
+		last := i
+		for j := i + 1; j < len(ops) && j-last <= 2*diffContext; j++ {
+			if ops[j].kind != ' ' {
+				last = j
+			}
+		}
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := last + diffContext + 1
+		if end > len(ops) {
+			end = len(ops)
+		}
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", nameA, nameB)
+		}
+		writeHunk(&sb, ops[start:end])
+		i = end
+	}
+	return sb.String()
+}
+
+func writeHunk(sb *strings.Builder, ops []diffOp) {
+	var na, nb int
+	for _, op := range ops {
+		if op.kind != '+' {
+			na++
+		}
+		if op.kind != '-' {
+			nb++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(ops[0].a, na), hunkRange(ops[0].b, nb))
+	for _, op := range ops {
+		sb.WriteByte(op.kind)
+		sb.WriteString(op.line)
+		sb.WriteByte('\n')
+	}
+}
+
+// hunkRange formats a 1-based line range, an empty range points at the line before it.
+func hunkRange(start, n int) string {
+	if n > 0 {
+		start++
+	}
+	return fmt.Sprintf("%d,%d", start, n)
+}
@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// applyOps 从编辑脚本还原出两侧文本，并返回改动的行数
+func applyOps(ops []diffOp) (a, b []string, edits int) {
+	for _, op := range ops {
+		switch op.kind {
+		case ' ':
+			a, b = append(a, op.line), append(b, op.line)
+		case '-':
+			a, edits = append(a, op.line), edits+1
+		case '+':
+			b, edits = append(b, op.line), edits+1
+		}
+	}
+	return
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		edits int
+	}{
+		{"empty", "", "", 0},
+		{"identical", "a\nb\nc", "a\nb\nc", 0},
+		{"insert only", "a\nc", "a\nb\nc\nd", 2},
+		{"delete only", "a\nb\nc\nd", "b\nd", 2},
+		{"from empty", "", "a\nb", 2},
+		{"to empty", "a\nb", "", 2},
+		{"replace", "a\nb\nc", "a\nx\nc", 2},
+		{"multibyte", "你好\n世界\n😀", "你好\n地球\n😀", 2},
+		{"classic", "a\nb\nc\na\nb\nb\na", "c\nb\na\nb\na\nc", 5},
+	}
+	for _, tt := range tests {
+		a, b := splitLines(tt.a), splitLines(tt.b)
+		ops := diffLines(a, b)
+		gotA, gotB, edits := applyOps(ops)
+		if !reflect.DeepEqual(gotA, a) || !reflect.DeepEqual(gotB, b) {
+			t.Errorf("%s: ops rebuild %q -> %q, want %q -> %q", tt.name, gotA, gotB, a, b)
+		}
+		if edits != tt.edits {
+			t.Errorf("%s: %d edits, want %d", tt.name, edits, tt.edits)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	if d := unifiedDiff("a", "b", "same\n", "same\n"); d != "" {
+		t.Fatalf("identical diff = %q", d)
+	}
+	// 相距不超过 2*diffContext 行的改动合并为一个 hunk
+	got := unifiedDiff("a", "b", "1\n2\n3\n4\n5\n6\n7\n8\n9\n10", "1\n2\n3\n4\n5\nfive\n6\n7\n8\n9\n")
+	want := "--- a\n+++ b\n@@ -3,8 +3,8 @@\n 3\n 4\n 5\n+five\n 6\n 7\n 8\n 9\n-10\n"
+	if got != want {
+		t.Fatalf("unifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+	var lines []string
+	for i := 1; i <= 20; i++ {
+		lines = append(lines, strconv.Itoa(i))
+	}
+	changed := append([]string(nil), lines...)
+	changed[1], changed[18] = "two", "nineteen"
+	got = unifiedDiff("a", "b", strings.Join(lines, "\n"), strings.Join(changed, "\n"))
+	if n := strings.Count(got, "@@ -"); n != 2 {
+		t.Fatalf("distant changes gave %d hunks:\n%s", n, got)
+	}
+	if !strings.Contains(got, "@@ -1,5 +1,5 @@\n 1\n-2\n+two\n") || !strings.Contains(got, "@@ -16,5 +16,5 @@\n") {
+		t.Fatalf("unexpected hunks:\n%s", got)
+	}
+	got = unifiedDiff("a", "b", "", "新\n")
+	want = "--- a\n+++ b\n@@ -0,0 +1,1 @@\n+新\n"
+	if got != want {
+		t.Fatalf("unifiedDiff from empty = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultHealthTimeout = time.Second
+
+// Dependency 一个外部依赖及其连通性检查
+type Dependency struct {
+	Name string
+	Ping func(ctx context.Context) error
+}
+
+// DependencyRepo 列出就绪检查需要访问的外部依赖
+type DependencyRepo interface {
+	Dependencies() []Dependency
+}
+
+// DependencyStatus 单个依赖的检查结果，Err 为 nil 表示可用
+type DependencyStatus struct {
+	Name    string
+	Err     error
+	Latency time.Duration
+}
+
+// Readiness 就绪检查结果
+type Readiness struct {
+	Ready        bool
+	Draining     bool // 正在关闭，不再接收新流量
+	Dependencies []*DependencyStatus
+}
+
+type HealthUsecase struct {
+	deps       []Dependency
+	timeout    time.Duration
+	drainDelay time.Duration
+	draining   atomic.Bool
+	drained    chan struct{}
+
+	mu       sync.Mutex // 同一时间只有一个共享检查在进行
+	cached   *Readiness
+	cachedAt time.Time
+
+	log *log.Helper
+}
+
+func NewHealthUsecase(c *conf.Server, repo DependencyRepo, logger log.Logger) *HealthUsecase {
+	timeout := c.GetHealth().GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultHealthTimeout
+	}
+	return &HealthUsecase{
+		deps:       repo.Dependencies(),
+		timeout:    timeout,
+		drainDelay: c.GetHealth().GetDrainDelay().AsDuration(),
+		drained:    make(chan struct{}),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// Ready 并发检查所有依赖，每个依赖单独计时；关闭过程中直接返回未就绪
+func (uc *HealthUsecase) Ready(ctx context.Context) *Readiness {
+	if uc.draining.Load() {
+		return &Readiness{Draining: true}
+	}
+	res := &Readiness{Ready: true, Dependencies: make([]*DependencyStatus, len(uc.deps))}
+	var wg sync.WaitGroup
+	for i, d := range uc.deps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, uc.timeout)
+			defer cancel()
+			start := time.Now()
+			err := d.Ping(ctx)
+			res.Dependencies[i] = &DependencyStatus{Name: d.Name, Err: err, Latency: time.Since(start)}
+		}()
+	}
+	wg.Wait()
+	for _, s := range res.Dependencies {
+		if s.Err != nil {
+			res.Ready = false
+			uc.log.WithContext(ctx).Warnf("readiness check %s failed: %v", s.Name, s.Err)
+		}
+	}
+	return res
+}
+
+// ReadyCached 返回 maxAge 内的上一次检查结果，供 Watch 等长连接共用；结果过期时只有一个调用方重新检查，
+// 其余调用方等待并共用它的结果。共享的检查不随单个调用方取消而中断
+func (uc *HealthUsecase) ReadyCached(ctx context.Context, maxAge time.Duration) *Readiness {
+	if uc.draining.Load() {
+		return &Readiness{Draining: true}
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if uc.cached != nil && time.Since(uc.cachedAt) < maxAge {
+		return uc.cached
+	}
+	uc.cached = uc.Ready(context.WithoutCancel(ctx))
+	uc.cachedAt = time.Now()
+	return uc.cached
+}
+
+// Drained 返回的 channel 在开始关闭时被关闭
+func (uc *HealthUsecase) Drained() <-chan struct{} {
+	return uc.drained
+}
+
+// Drain 标记为关闭中，此后就绪检查一律失败；再等待 drain_delay 让负载均衡摘除本实例
+func (uc *HealthUsecase) Drain(ctx context.Context) error {
+	if uc.draining.Swap(true) {
+		return nil
+	}
+	close(uc.drained)
+	uc.log.Infof("draining: reporting not ready for %s before stopping", uc.drainDelay)
+	if uc.drainDelay <= 0 {
+		return nil
+	}
+	t := time.NewTimer(uc.drainDelay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
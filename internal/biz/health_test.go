@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type fakeDependencyRepo []Dependency
+
+func (r fakeDependencyRepo) Dependencies() []Dependency { return r }
+
+// countingPing 返回 err 并记录调用次数
+func countingPing(n *atomic.Int32, err error) func(context.Context) error {
+	return func(context.Context) error {
+		n.Add(1)
+		return err
+	}
+}
+
+func newTestHealthUsecase(deps ...Dependency) *HealthUsecase {
+	c := &conf.Server{Health: &conf.Server_Health{Timeout: durationpb.New(50 * time.Millisecond)}}
+	return NewHealthUsecase(c, fakeDependencyRepo(deps), log.DefaultLogger)
+}
+
+func TestHealthReadyAggregates(t *testing.T) {
+	var n atomic.Int32
+	down := errors.New("connection refused")
+	tests := []struct {
+		name  string
+		deps  []Dependency
+		ready bool
+	}{
+		{"no dependencies", nil, true},
+		{"all up", []Dependency{{"mysql", countingPing(&n, nil)}, {"redis", countingPing(&n, nil)}}, true},
+		{"one down", []Dependency{{"mysql", countingPing(&n, nil)}, {"redis", countingPing(&n, down)}}, false},
+		{"timeout", []Dependency{{"mysql", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := newTestHealthUsecase(tt.deps...).Ready(context.Background())
+			if res.Ready != tt.ready || res.Draining {
+				t.Fatalf("ready = %v draining = %v, want ready %v", res.Ready, res.Draining, tt.ready)
+			}
+			if len(res.Dependencies) != len(tt.deps) {
+				t.Fatalf("%d dependency results, want %d", len(res.Dependencies), len(tt.deps))
+			}
+			for i, d := range res.Dependencies {
+				if d.Name != tt.deps[i].Name {
+					t.Fatalf("dependency %d = %s, want %s", i, d.Name, tt.deps[i].Name)
+				}
+			}
+		})
+	}
+}
+
+func TestHealthDrain(t *testing.T) {
+	var n atomic.Int32
+	uc := newTestHealthUsecase(Dependency{"mysql", countingPing(&n, nil)})
+	if !uc.Ready(context.Background()).Ready {
+		t.Fatal("not ready before drain")
+	}
+	if err := uc.Drain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-uc.Drained():
+	default:
+		t.Fatal("Drained not closed after Drain")
+	}
+	for _, res := range []*Readiness{uc.Ready(context.Background()), uc.ReadyCached(context.Background(), time.Hour)} {
+		if res.Ready || !res.Draining {
+			t.Fatalf("after drain ready = %v draining = %v", res.Ready, res.Draining)
+		}
+	}
+	if n.Load() != 1 {
+		t.Fatalf("pinged %d times, want no pings while draining", n.Load()-1)
+	}
+	// 重复调用不会再次关闭 channel
+	if err := uc.Drain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHealthReadyCachedShared(t *testing.T) {
+	var n atomic.Int32
+	uc := newTestHealthUsecase(Dependency{"mysql", countingPing(&n, nil)})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !uc.ReadyCached(context.Background(), time.Minute).Ready {
+				t.Error("not ready")
+			}
+		}()
+	}
+	wg.Wait()
+	if n.Load() != 1 {
+		t.Fatalf("20 callers pinged %d times, want 1", n.Load())
+	}
+
+	// 过期后重新检查
+	uc.ReadyCached(context.Background(), 0)
+	if n.Load() != 2 {
+		t.Fatalf("expired cache pinged %d times, want 2", n.Load())
+	}
+}
+
+func TestHealthReadyCachedIgnoresCallerCancel(t *testing.T) {
+	uc := newTestHealthUsecase(Dependency{"mysql", func(ctx context.Context) error { return ctx.Err() }})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// 一个已经断开的调用方不能让共享结果变成未就绪
+	if !uc.ReadyCached(ctx, time.Minute).Ready {
+		t.Fatal("canceled caller made the shared result not ready")
+	}
+}
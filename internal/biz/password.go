@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"agdemo/internal/conf"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	PasswordHasherBcrypt   = "bcrypt"
+	PasswordHasherArgon2id = "argon2id"
+)
+
+const (
+	defaultArgon2Time    = 1
+	defaultArgon2Memory  = 64 * 1024
+	defaultArgon2Threads = 4
+	argon2SaltLen        = 16
+	argon2KeyLen         = 32
+)
+
+// PasswordHasher hashes passwords with the configured algorithm and verifies
+// hashes made by either supported algorithm.
+type PasswordHasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     argon2Params
+}
+
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+func NewPasswordHasher(c *conf.Auth) (*PasswordHasher, error) {
+	p := c.GetPassword()
+	h := &PasswordHasher{
+		algorithm:  p.GetHasher(),
+		bcryptCost: int(p.GetBcryptCost()),
+		argon2: argon2Params{
+			time:    p.GetArgon2Time(),
+			memory:  p.GetArgon2Memory(),
+			threads: uint8(p.GetArgon2Threads()),
+		},
+	}
+	if h.algorithm == "" {
+		h.algorithm = PasswordHasherBcrypt
+	}
+	if h.algorithm != PasswordHasherBcrypt && h.algorithm != PasswordHasherArgon2id {
+		return nil, fmt.Errorf("unknown password hasher %q", h.algorithm)
+	}
+	if h.bcryptCost == 0 {
+		h.bcryptCost = bcrypt.DefaultCost
+	}
+	if h.bcryptCost < bcrypt.MinCost || h.bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost %d out of range [%d, %d]", h.bcryptCost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	if h.argon2.time == 0 {
+		h.argon2.time = defaultArgon2Time
+	}
+	if h.argon2.memory == 0 {
+		h.argon2.memory = defaultArgon2Memory
+	}
+	if h.argon2.threads == 0 {
+		h.argon2.threads = defaultArgon2Threads
+	}
+	return h, nil
+}
+
+// Hash hashes the password with the configured algorithm.
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	if h.algorithm == PasswordHasherArgon2id {
+		salt := make([]byte, argon2SaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		return h.argon2.encode(salt, h.argon2.key(password, salt)), nil
+	}
+	b, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Verify reports whether the password matches the hash, whichever algorithm made it.
+func (h *PasswordHasher) Verify(hash, password string) (bool, error) {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		p, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return false, err
+		}
+		return subtle.ConstantTimeCompare(key, p.key(password, salt)) == 1, nil
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// NeedsRehash reports whether the hash was made with another algorithm or other costs.
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	if h.algorithm == PasswordHasherArgon2id {
+		p, _, _, err := decodeArgon2(hash)
+		return err != nil || p != h.argon2
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.bcryptCost
+}
+
+func (p argon2Params) key(password string, salt []byte) []byte {
+	return argon2.IDKey([]byte(password), salt, p.time, p.memory, p.threads, argon2KeyLen)
+}
+
+// encode 采用 PHC 字符串格式：$argon2id$v=19$m=65536,t=1,p=4$<salt>$<key>
+func (p argon2Params) encode(salt, key []byte) string {
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.memory, p.time, p.threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+func decodeArgon2(hash string) (p argon2Params, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return p, nil, nil, fmt.Errorf("malformed argon2id hash")
+	}
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id parameters: %w", err)
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id salt: %w", err)
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id key: %w", err)
+	}
+	return p, salt, key, nil
+}
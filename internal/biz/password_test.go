@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"agdemo/internal/conf"
+	"strings"
+	"testing"
+)
+
+// 测试用较低的代价，避免拖慢用例
+func testHasher(t *testing.T, algorithm string, bcryptCost int32, argon2Memory uint32) *PasswordHasher {
+	t.Helper()
+	h, err := NewPasswordHasher(&conf.Auth{Password: &conf.Auth_Password{
+		Hasher:        algorithm,
+		BcryptCost:    bcryptCost,
+		Argon2Time:    1,
+		Argon2Memory:  argon2Memory,
+		Argon2Threads: 1,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestPasswordHasher(t *testing.T) {
+	bc := testHasher(t, PasswordHasherBcrypt, 4, 1024)
+	ar := testHasher(t, PasswordHasherArgon2id, 4, 1024)
+	for _, h := range []*PasswordHasher{bc, ar} {
+		t.Run(h.algorithm, func(t *testing.T) {
+			hash, err := h.Hash("s3cret")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if h.algorithm == PasswordHasherArgon2id && !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+				t.Fatalf("argon2id hash = %q", hash)
+			}
+			again, _ := h.Hash("s3cret")
+			if again == hash {
+				t.Fatal("hashes should be salted")
+			}
+			// 两种哈希都能被任一配置校验
+			for _, v := range []*PasswordHasher{bc, ar} {
+				if ok, err := v.Verify(hash, "s3cret"); !ok || err != nil {
+					t.Fatalf("%s verify = %v, %v", v.algorithm, ok, err)
+				}
+				if ok, err := v.Verify(hash, "wrong"); ok || err != nil {
+					t.Fatalf("%s verify wrong password = %v, %v", v.algorithm, ok, err)
+				}
+			}
+			if h.NeedsRehash(hash) {
+				t.Fatal("fresh hash needs no rehash")
+			}
+		})
+	}
+}
+
+func TestPasswordHasherNeedsRehash(t *testing.T) {
+	bcrypt4 := testHasher(t, PasswordHasherBcrypt, 4, 1024)
+	bcrypt5 := testHasher(t, PasswordHasherBcrypt, 5, 1024)
+	argonSmall := testHasher(t, PasswordHasherArgon2id, 4, 1024)
+	argonLarge := testHasher(t, PasswordHasherArgon2id, 4, 2048)
+	hashOf := func(h *PasswordHasher) string {
+		s, err := h.Hash("pw")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	tests := []struct {
+		name string
+		h    *PasswordHasher
+		hash string
+		want bool
+	}{
+		{"same bcrypt cost", bcrypt4, hashOf(bcrypt4), false},
+		{"other bcrypt cost", bcrypt5, hashOf(bcrypt4), true},
+		{"bcrypt to argon2id", argonSmall, hashOf(bcrypt4), true},
+		{"argon2id to bcrypt", bcrypt4, hashOf(argonSmall), true},
+		{"same argon2 params", argonSmall, hashOf(argonSmall), false},
+		{"other argon2 memory", argonLarge, hashOf(argonSmall), true},
+		{"garbage", bcrypt4, "not a hash", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.NeedsRehash(tt.hash); got != tt.want {
+				t.Fatalf("NeedsRehash = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherMalformed(t *testing.T) {
+	h := testHasher(t, PasswordHasherArgon2id, 4, 1024)
+	for _, hash := range []string{
+		"$argon2id$v=19$m=1024,t=1,p=1$salt",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!$a2V5",
+		"$2a$04$short",
+	} {
+		if ok, err := h.Verify(hash, "pw"); ok || err == nil {
+			t.Fatalf("Verify(%q) = %v, %v, want an error", hash, ok, err)
+		}
+	}
+}
+
+func TestNewPasswordHasherConfig(t *testing.T) {
+	if h, err := NewPasswordHasher(&conf.Auth{}); err != nil || h.algorithm != PasswordHasherBcrypt {
+		t.Fatalf("default hasher = %+v, %v", h, err)
+	}
+	for _, p := range []*conf.Auth_Password{
+		{Hasher: "md5"},
+		{BcryptCost: 99},
+	} {
+		if _, err := NewPasswordHasher(&conf.Auth{Password: p}); err == nil {
+			t.Fatalf("config %+v should be rejected", p)
+		}
+	}
+}
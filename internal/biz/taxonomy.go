@@ -0,0 +1,130 @@
+package biz
+
+import (
+	pb "agdemo/api/blog/v1"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrTagNotFound is tag not found.
+	ErrTagNotFound = errors.NotFound(pb.ErrorReason_TAG_NOT_FOUND.String(), "tag not found")
+	// ErrTagDuplicate is returned when another tag already uses the name.
+	ErrTagDuplicate = errors.Conflict(pb.ErrorReason_TAG_DUPLICATE.String(), "tag name already exists")
+	// ErrCategoryNotFound is category not found.
+	ErrCategoryNotFound = errors.NotFound(pb.ErrorReason_CATEGORY_NOT_FOUND.String(), "category not found")
+	// ErrCategoryDuplicate is returned when another category already uses the name.
+	ErrCategoryDuplicate = errors.Conflict(pb.ErrorReason_CATEGORY_DUPLICATE.String(), "category name already exists")
+)
+
+type Tag struct {
+	Id           int64
+	Name         string
+	ArticleCount int64 // published articles carrying the tag, only filled by ListTags
+	CreatedAt    time.Time
+}
+
+func (t *Tag) ToProto() *pb.Tag {
+	return &pb.Tag{
+		Id:           t.Id,
+		Name:         t.Name,
+		ArticleCount: t.ArticleCount,
+	}
+}
+
+type Category struct {
+	Id           int64
+	Name         string
+	Description  string
+	ArticleCount int64 // published articles in the category, only filled by ListCategories
+	CreatedAt    time.Time
+}
+
+func (c *Category) ToProto() *pb.Category {
+	return &pb.Category{
+		Id:           c.Id,
+		Name:         c.Name,
+		Description:  c.Description,
+		ArticleCount: c.ArticleCount,
+	}
+}
+
+// normalizeTags 去重并排序，文章上的标签顺序无意义
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		if !seen[t] {
+			seen[t] = true
+			res = append(res, t)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// TaxonomyRepo stores tags and categories; which articles carry them is kept by ArticleRepo.
+type TaxonomyRepo interface {
+	CreateTag(ctx context.Context, tag *Tag) error
+	// UpdateTag renames the tag.
+	UpdateTag(ctx context.Context, tag *Tag) error
+	// DeleteTag removes the tag from every article too.
+	DeleteTag(ctx context.Context, id int64) error
+	// ListTags returns every tag with its article count, by name.
+	ListTags(ctx context.Context) ([]*Tag, error)
+
+	CreateCategory(ctx context.Context, category *Category) error
+	UpdateCategory(ctx context.Context, category *Category) error
+	// DeleteCategory leaves the articles of the category uncategorized.
+	DeleteCategory(ctx context.Context, id int64) error
+	// ListCategories returns every category with its article count, by name.
+	ListCategories(ctx context.Context) ([]*Category, error)
+}
+
+type TaxonomyUsecase struct {
+	repo TaxonomyRepo
+	log  *log.Helper
+}
+
+func NewTaxonomyUsecase(repo TaxonomyRepo, logger log.Logger) *TaxonomyUsecase {
+	return &TaxonomyUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func (uc *TaxonomyUsecase) CreateTag(ctx context.Context, tag *Tag) error {
+	return uc.repo.CreateTag(ctx, tag)
+}
+
+func (uc *TaxonomyUsecase) UpdateTag(ctx context.Context, tag *Tag) error {
+	return uc.repo.UpdateTag(ctx, tag)
+}
+
+func (uc *TaxonomyUsecase) DeleteTag(ctx context.Context, id int64) error {
+	return uc.repo.DeleteTag(ctx, id)
+}
+
+func (uc *TaxonomyUsecase) ListTags(ctx context.Context) ([]*Tag, error) {
+	return uc.repo.ListTags(ctx)
+}
+
+func (uc *TaxonomyUsecase) CreateCategory(ctx context.Context, category *Category) error {
+	return uc.repo.CreateCategory(ctx, category)
+}
+
+func (uc *TaxonomyUsecase) UpdateCategory(ctx context.Context, category *Category) error {
+	return uc.repo.UpdateCategory(ctx, category)
+}
+
+func (uc *TaxonomyUsecase) DeleteCategory(ctx context.Context, id int64) error {
+	return uc.repo.DeleteCategory(ctx, id)
+}
+
+func (uc *TaxonomyUsecase) ListCategories(ctx context.Context) ([]*Category, error) {
+	return uc.repo.ListCategories(ctx)
+}
@@ -0,0 +1,268 @@
+package biz
+
+import (
+	pb "agdemo/api/blog/v1"
+	"agdemo/internal/conf"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrAccountNotFound is returned when no user account has the id or username;
+	// ErrUserNotFound in greeter.go belongs to the helloworld api.
+	ErrAccountNotFound = errors.NotFound(pb.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrUsernameTaken is returned when another user already has the username.
+	ErrUsernameTaken = errors.Conflict(pb.ErrorReason_USERNAME_TAKEN.String(), "username already taken")
+	// ErrInvalidCredentials is returned on login with an unknown username or a wrong password.
+	ErrInvalidCredentials = errors.Unauthorized(pb.ErrorReason_INVALID_CREDENTIALS.String(), "invalid username or password")
+	// ErrUnauthenticated is returned when an operation needs a caller but there is none.
+	ErrUnauthenticated = errors.Unauthorized(pb.ErrorReason_UNAUTHENTICATED.String(), "authentication required")
+	// ErrUnknownRole is returned when a role is not defined in auth.authz.
+	ErrUnknownRole = errors.BadRequest(pb.ErrorReason_UNKNOWN_ROLE.String(), "unknown role")
+)
+
+const defaultRole = "author"
+
+// User fields that can be named in an update mask.
+const (
+	UserFieldDisplayName = "display_name"
+	UserFieldBio         = "bio"
+	UserFieldPassword    = "password_hash" // internal, set when a hash is upgraded
+	UserFieldRole        = "role"          // internal, set through SetRole
+)
+
+type User struct {
+	Id           int64
+	Username     string
+	PasswordHash string
+	DisplayName  string
+	Bio          string
+	Role         string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (u *User) ToProto() *pb.User {
+	return &pb.User{
+		Id:          u.Id,
+		Username:    u.Username,
+		DisplayName: u.DisplayName,
+		Bio:         u.Bio,
+		CreatedAt:   timestamp(u.CreatedAt),
+		Role:        u.Role,
+	}
+}
+
+// Summary is the public part embedded in other resources, nil safe.
+func (u *User) Summary() *pb.UserSummary {
+	if u == nil {
+		return nil
+	}
+	return &pb.UserSummary{Id: u.Id, Username: u.Username, DisplayName: u.DisplayName}
+}
+
+// Caller is the authenticated identity behind a request.
+type Caller struct {
+	UserId   int64
+	Username string
+	Role     string // current role, reloaded by the authz middleware on every request
+
+	// set when the caller authenticated with an api key, UserId is then the
+	// user who minted it, Role is that user's and Scopes narrow it down
+	ApiKeyId int64
+	Scopes   []string
+}
+
+type callerKey struct{}
+
+// NewCallerContext returns a context carrying the caller, set by the auth middleware.
+func NewCallerContext(ctx context.Context, c *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// CallerFromContext returns the caller of the request, if authenticated.
+func CallerFromContext(ctx context.Context) (*Caller, bool) {
+	c, ok := ctx.Value(callerKey{}).(*Caller)
+	return c, ok && c != nil
+}
+
+// AccessToken is a bearer token issued on login.
+type AccessToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// TokenIssuer signs access tokens for authenticated users.
+type TokenIssuer interface {
+	// IssueToken returns nil when the server is not configured to issue tokens.
+	IssueToken(ctx context.Context, c *Caller) (*AccessToken, error)
+}
+
+type UserRepo interface {
+	// CreateUser fails with ErrUsernameTaken when the username is in use.
+	CreateUser(ctx context.Context, u *User) error
+	GetUser(ctx context.Context, id int64) (*User, error)
+	GetUserByUsername(ctx context.Context, username string) (*User, error)
+	// UpdateUser writes the named fields of u.
+	UpdateUser(ctx context.Context, u *User, fields []string) error
+}
+
+type UserUsecase struct {
+	repo   UserRepo
+	hasher *PasswordHasher
+	tokens TokenIssuer
+
+	defaultRole string
+	roles       map[string]bool // 为空时不校验角色名
+
+	dummyOnce sync.Once
+	dummyHash string // 用户不存在时也做一次校验，避免按耗时探测用户名
+
+	log *log.Helper
+}
+
+func NewUserUsecase(c *conf.Auth, repo UserRepo, tokens TokenIssuer, logger log.Logger) (*UserUsecase, error) {
+	hasher, err := NewPasswordHasher(c)
+	if err != nil {
+		return nil, err
+	}
+	uc := &UserUsecase{
+		repo:        repo,
+		hasher:      hasher,
+		tokens:      tokens,
+		defaultRole: c.GetAuthz().GetDefaultRole(),
+		roles:       make(map[string]bool),
+		log:         log.NewHelper(logger),
+	}
+	if uc.defaultRole == "" {
+		uc.defaultRole = defaultRole
+	}
+	for _, r := range c.GetAuthz().GetRoles() {
+		uc.roles[r.Name] = true
+	}
+	if len(uc.roles) > 0 && !uc.roles[uc.defaultRole] {
+		return nil, fmt.Errorf("default role %q is not defined", uc.defaultRole)
+	}
+	return uc, nil
+}
+
+// Register creates a user, usernames are case insensitive and stored lower-cased.
+func (uc *UserUsecase) Register(ctx context.Context, u *User, password string) error {
+	u.Username = strings.ToLower(u.Username)
+	u.Role = uc.defaultRole
+	if u.DisplayName == "" {
+		u.DisplayName = u.Username
+	}
+	hash, err := uc.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = hash
+	return uc.repo.CreateUser(ctx, u)
+}
+
+// Login checks the password and returns the user with an access token, the
+// token is nil when the server issues none.
+func (uc *UserUsecase) Login(ctx context.Context, username, password string) (*User, *AccessToken, error) {
+	u, err := uc.repo.GetUserByUsername(ctx, strings.ToLower(username))
+	if ErrAccountNotFound.Is(err) {
+		uc.dummyOnce.Do(func() { uc.dummyHash, _ = uc.hasher.Hash("dummy password") })
+		_, _ = uc.hasher.Verify(uc.dummyHash, password)
+		return nil, nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	ok, err := uc.hasher.Verify(u.PasswordHash, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify password of user %d: %w", u.Id, err)
+	}
+	if !ok {
+		return nil, nil, ErrInvalidCredentials
+	}
+	if uc.hasher.NeedsRehash(u.PasswordHash) {
+		uc.rehash(ctx, u, password)
+	}
+	token, err := uc.tokens.IssueToken(ctx, &Caller{UserId: u.Id, Username: u.Username, Role: u.Role})
+	if err != nil {
+		return nil, nil, err
+	}
+	return u, token, nil
+}
+
+// rehash 按当前配置重新哈希，失败只记录日志，不影响本次登录
+func (uc *UserUsecase) rehash(ctx context.Context, u *User, password string) {
+	hash, err := uc.hasher.Hash(password)
+	if err == nil {
+		u.PasswordHash = hash
+		err = uc.repo.UpdateUser(ctx, u, []string{UserFieldPassword})
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("rehash password of user %d: %v", u.Id, err)
+	}
+}
+
+func (uc *UserUsecase) Get(ctx context.Context, id int64) (*User, error) {
+	return uc.repo.GetUser(ctx, id)
+}
+
+// Me returns the authenticated caller.
+func (uc *UserUsecase) Me(ctx context.Context) (*User, error) {
+	c, ok := CallerFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.repo.GetUser(ctx, c.UserId)
+}
+
+// UpdateProfile writes the fields of the caller named in mask, display_name
+// and bio when mask is empty, and returns the user as stored afterwards.
+func (uc *UserUsecase) UpdateProfile(ctx context.Context, u *User, mask []string) (*User, error) {
+	c, ok := CallerFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	if len(mask) == 0 {
+		mask = []string{UserFieldDisplayName, UserFieldBio}
+	}
+	for _, f := range mask {
+		if f != UserFieldDisplayName && f != UserFieldBio {
+			return nil, errors.BadRequest(ErrInvalidUpdateMask.Reason, fmt.Sprintf("unknown field %q in update_mask", f))
+		}
+	}
+	u.Id = c.UserId
+	if err := uc.repo.UpdateUser(ctx, u, mask); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetUser(ctx, u.Id)
+}
+
+// RoleOf returns the current role of the user, ErrUnauthenticated when the
+// account is gone, so a role change applies to tokens issued before it.
+func (uc *UserUsecase) RoleOf(ctx context.Context, id int64) (string, error) {
+	u, err := uc.repo.GetUser(ctx, id)
+	if ErrAccountNotFound.Is(err) {
+		return "", ErrUnauthenticated
+	}
+	if err != nil {
+		return "", err
+	}
+	return u.Role, nil
+}
+
+// SetRole changes the role of a user, effective on the next request of the user.
+func (uc *UserUsecase) SetRole(ctx context.Context, id int64, role string) (*User, error) {
+	if len(uc.roles) > 0 && !uc.roles[role] {
+		return nil, ErrUnknownRole
+	}
+	if err := uc.repo.UpdateUser(ctx, &User{Id: id, Role: role}, []string{UserFieldRole}); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetUser(ctx, id)
+}
@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeUserRepo struct {
+	users   map[string]*User
+	updates int
+}
+
+func (r *fakeUserRepo) CreateUser(ctx context.Context, u *User) error {
+	if _, ok := r.users[u.Username]; ok {
+		return ErrUsernameTaken
+	}
+	u.Id = int64(len(r.users) + 1)
+	r.users[u.Username] = u
+	return nil
+}
+
+func (r *fakeUserRepo) GetUser(ctx context.Context, id int64) (*User, error) {
+	for _, u := range r.users {
+		if u.Id == id {
+			return u, nil
+		}
+	}
+	return nil, ErrAccountNotFound
+}
+
+func (r *fakeUserRepo) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	u, ok := r.users[username]
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (r *fakeUserRepo) UpdateUser(ctx context.Context, u *User, fields []string) error {
+	r.updates++
+	stored := r.users[u.Username]
+	for _, f := range fields {
+		if f == UserFieldPassword {
+			stored.PasswordHash = u.PasswordHash
+		}
+	}
+	return nil
+}
+
+type fakeTokenIssuer struct{}
+
+func (fakeTokenIssuer) IssueToken(ctx context.Context, c *Caller) (*AccessToken, error) {
+	return &AccessToken{Token: c.Username, ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+func newTestUserUsecase(t *testing.T, repo UserRepo, hasher string, cost int32) *UserUsecase {
+	t.Helper()
+	uc, err := NewUserUsecase(&conf.Auth{Password: &conf.Auth_Password{
+		Hasher: hasher, BcryptCost: cost, Argon2Memory: 1024, Argon2Threads: 1,
+	}}, repo, fakeTokenIssuer{}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return uc
+}
+
+func TestLoginRehash(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeUserRepo{users: make(map[string]*User)}
+	old := newTestUserUsecase(t, repo, PasswordHasherBcrypt, 4)
+	if err := old.Register(ctx, &User{Username: "Alice"}, "pw"); err != nil {
+		t.Fatal(err)
+	}
+	before := repo.users["alice"].PasswordHash
+
+	// 同样的配置登录不重写哈希
+	u, token, err := old.Login(ctx, "ALICE", "pw")
+	if err != nil || u.Username != "alice" || token == nil || token.Token != "alice" {
+		t.Fatalf("Login = %+v, %+v, %v", u, token, err)
+	}
+	if repo.updates != 0 {
+		t.Fatalf("hash rewritten %d times", repo.updates)
+	}
+
+	// 换成 argon2id 后登录一次即升级
+	upgraded := newTestUserUsecase(t, repo, PasswordHasherArgon2id, 4)
+	if _, _, err = upgraded.Login(ctx, "alice", "pw"); err != nil {
+		t.Fatal(err)
+	}
+	after := repo.users["alice"].PasswordHash
+	if repo.updates != 1 || after == before || upgraded.hasher.NeedsRehash(after) {
+		t.Fatalf("updates %d, hash %q", repo.updates, after)
+	}
+	// 错误的密码不触发升级
+	if _, _, err = old.Login(ctx, "alice", "wrong"); !ErrInvalidCredentials.Is(err) {
+		t.Fatalf("wrong password err = %v", err)
+	}
+	if repo.updates != 1 {
+		t.Fatalf("hash rewritten on a failed login")
+	}
+	if _, _, err = old.Login(ctx, "alice", "pw"); err != nil || repo.updates != 2 {
+		t.Fatalf("downgrade login = %v, updates %d", err, repo.updates)
+	}
+}
+
+func TestLoginUnknownUser(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeUserRepo{users: make(map[string]*User)}
+	uc := newTestUserUsecase(t, repo, PasswordHasherBcrypt, 5)
+	if _, _, err := uc.Login(ctx, "nobody", "pw"); !ErrInvalidCredentials.Is(err) {
+		t.Fatalf("unknown user err = %v", err)
+	}
+	// 不存在的用户也按当前配置校验一次哈希，耗时与密码错误时相同
+	if uc.dummyHash == "" || uc.hasher.NeedsRehash(uc.dummyHash) {
+		t.Fatalf("dummy hash %q not made with the configured hasher", uc.dummyHash)
+	}
+	if ok, err := uc.hasher.Verify(uc.dummyHash, "pw"); ok || err != nil {
+		t.Fatalf("dummy hash verify = %v, %v", ok, err)
+	}
+}
+
+func TestRoleOf(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeUserRepo{users: make(map[string]*User)}
+	uc := newTestUserUsecase(t, repo, PasswordHasherBcrypt, 4)
+	if err := uc.Register(ctx, &User{Username: "bob"}, "pw"); err != nil {
+		t.Fatal(err)
+	}
+	repo.users["bob"].Role = "editor"
+	if role, err := uc.RoleOf(ctx, repo.users["bob"].Id); err != nil || role != "editor" {
+		t.Fatalf("RoleOf = %q, %v", role, err)
+	}
+	if _, err := uc.RoleOf(ctx, 404); !ErrUnauthenticated.Is(err) {
+		t.Fatalf("RoleOf deleted user err = %v", err)
+	}
+}
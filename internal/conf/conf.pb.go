@@ -26,6 +26,7 @@ type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,10 +75,21 @@ func (x *Bootstrap) GetData() *Data {
 	return nil
 }
 
+func (x *Bootstrap) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	RateLimit     *Server_RateLimit      `protobuf:"bytes,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	Adaptive      *Server_Adaptive       `protobuf:"bytes,4,opt,name=adaptive,proto3" json:"adaptive,omitempty"`
+	Health        *Server_Health         `protobuf:"bytes,5,opt,name=health,proto3" json:"health,omitempty"`
+	Admin         *Server_Admin          `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,10 +138,43 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetRateLimit() *Server_RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *Server) GetAdaptive() *Server_Adaptive {
+	if x != nil {
+		return x.Adaptive
+	}
+	return nil
+}
+
+func (x *Server) GetHealth() *Server_Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Server) GetAdmin() *Server_Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	LikeFlush     *Data_LikeFlush        `protobuf:"bytes,3,opt,name=like_flush,json=likeFlush,proto3" json:"like_flush,omitempty"`
+	Trash         *Data_Trash            `protobuf:"bytes,4,opt,name=trash,proto3" json:"trash,omitempty"`
+	Publish       *Data_Publish          `protobuf:"bytes,5,opt,name=publish,proto3" json:"publish,omitempty"`
+	Search        *Data_Search           `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	Comment       *Data_Comment          `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,6 +223,101 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetLikeFlush() *Data_LikeFlush {
+	if x != nil {
+		return x.LikeFlush
+	}
+	return nil
+}
+
+func (x *Data) GetTrash() *Data_Trash {
+	if x != nil {
+		return x.Trash
+	}
+	return nil
+}
+
+func (x *Data) GetPublish() *Data_Publish {
+	if x != nil {
+		return x.Publish
+	}
+	return nil
+}
+
+func (x *Data) GetSearch() *Data_Search {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *Data) GetComment() *Data_Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type Auth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      *Auth_Password         `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Jwt           *Auth_JWT              `protobuf:"bytes,2,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	Authz         *Auth_Authz            `protobuf:"bytes,3,opt,name=authz,proto3" json:"authz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Auth) GetPassword() *Auth_Password {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *Auth) GetJwt() *Auth_JWT {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+func (x *Auth) GetAuthz() *Auth_Authz {
+	if x != nil {
+		return x.Authz
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -189,7 +329,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +341,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +389,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +401,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,31 +438,42 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
-type Data_Database struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	MaxIdleConns  int32                  `protobuf:"varint,3,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
-	MaxOpenConns  int32                  `protobuf:"varint,4,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
+// token buckets shared by both servers, one per rule and key
+type Server_RateLimit struct {
+	state protoimpl.MessageState   `protogen:"open.v1"`
+	Rules []*Server_RateLimit_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // the first matching rule applies
+	// applies per caller to operations no rule matches, unset means unlimited
+	DefaultQuota *Server_RateLimit_Quota `protobuf:"bytes,2,opt,name=default_quota,json=defaultQuota,proto3" json:"default_quota,omitempty"`
+	MaxBuckets   int32                   `protobuf:"varint,3,opt,name=max_buckets,json=maxBuckets,proto3" json:"max_buckets,omitempty"` // least recently used buckets are dropped beyond it, defaults to 10000
+	// take the client ip from X-Forwarded-For / X-Real-IP, only behind a trusted proxy
+	TrustForwardedFor bool `protobuf:"varint,4,opt,name=trust_forwarded_for,json=trustForwardedFor,proto3" json:"trust_forwarded_for,omitempty"`
+	// local (default) keeps buckets in process; redis shares them between replicas
+	// and falls back to the local buckets while redis is unreachable
+	Backend      string               `protobuf:"bytes,5,opt,name=backend,proto3" json:"backend,omitempty"`
+	Algorithm    string               `protobuf:"bytes,6,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                           // redis backend: token_bucket (default) or sliding_window
+	RedisTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=redis_timeout,json=redisTimeout,proto3" json:"redis_timeout,omitempty"` // per check, defaults to 100ms
+	// per client ip on every request, checked before authentication so api keys
+	// and tokens can't be guessed at the speed of the per caller quotas; unset means unlimited
+	PreAuth       *Server_RateLimit_Quota `protobuf:"bytes,8,opt,name=pre_auth,json=preAuth,proto3" json:"pre_auth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Database) Reset() {
-	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+func (x *Server_RateLimit) Reset() {
+	*x = Server_RateLimit{}
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Database) String() string {
+func (x *Server_RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Database) ProtoMessage() {}
+func (*Server_RateLimit) ProtoMessage() {}
 
-func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+func (x *Server_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,66 +484,103 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
-func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use Server_RateLimit.ProtoReflect.Descriptor instead.
+func (*Server_RateLimit) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 2}
 }
 
-func (x *Data_Database) GetDriver() string {
+func (x *Server_RateLimit) GetRules() []*Server_RateLimit_Rule {
 	if x != nil {
-		return x.Driver
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Server_RateLimit) GetDefaultQuota() *Server_RateLimit_Quota {
+	if x != nil {
+		return x.DefaultQuota
+	}
+	return nil
+}
+
+func (x *Server_RateLimit) GetMaxBuckets() int32 {
+	if x != nil {
+		return x.MaxBuckets
+	}
+	return 0
+}
+
+func (x *Server_RateLimit) GetTrustForwardedFor() bool {
+	if x != nil {
+		return x.TrustForwardedFor
+	}
+	return false
+}
+
+func (x *Server_RateLimit) GetBackend() string {
+	if x != nil {
+		return x.Backend
 	}
 	return ""
 }
 
-func (x *Data_Database) GetSource() string {
+func (x *Server_RateLimit) GetAlgorithm() string {
 	if x != nil {
-		return x.Source
+		return x.Algorithm
 	}
 	return ""
 }
 
-func (x *Data_Database) GetMaxIdleConns() int32 {
+func (x *Server_RateLimit) GetRedisTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.MaxIdleConns
+		return x.RedisTimeout
 	}
-	return 0
+	return nil
 }
 
-func (x *Data_Database) GetMaxOpenConns() int32 {
+func (x *Server_RateLimit) GetPreAuth() *Server_RateLimit_Quota {
 	if x != nil {
-		return x.MaxOpenConns
+		return x.PreAuth
 	}
-	return 0
+	return nil
 }
 
-type Data_Redis struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
-	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
-	Db            int32                  `protobuf:"varint,5,opt,name=db,proto3" json:"db,omitempty"`
-	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// load shedding for the whole process, checked before authentication
+type Server_Adaptive struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bbr drops requests while cpu is above cpu_threshold and in-flight requests
+	// exceed max pass rate * min latency; aimd keeps a concurrency limit that grows
+	// by one while requests finish under latency_threshold and is multiplied by
+	// backoff_ratio when they don't, so it also reacts to a slow database.
+	// empty disables shedding
+	Algorithm        string               `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	CpuThreshold     int32                `protobuf:"varint,2,opt,name=cpu_threshold,json=cpuThreshold,proto3" json:"cpu_threshold,omitempty"`            // bbr, per mille, defaults to 800
+	Window           *durationpb.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`                                             // bbr statistics window, defaults to 10s
+	Buckets          int32                `protobuf:"varint,4,opt,name=buckets,proto3" json:"buckets,omitempty"`                                          // bbr buckets per window, defaults to 100
+	InitialLimit     int32                `protobuf:"varint,5,opt,name=initial_limit,json=initialLimit,proto3" json:"initial_limit,omitempty"`            // aimd, defaults to 20
+	MinLimit         int32                `protobuf:"varint,6,opt,name=min_limit,json=minLimit,proto3" json:"min_limit,omitempty"`                        // aimd, defaults to 1
+	MaxLimit         int32                `protobuf:"varint,7,opt,name=max_limit,json=maxLimit,proto3" json:"max_limit,omitempty"`                        // aimd, defaults to 200
+	LatencyThreshold *durationpb.Duration `protobuf:"bytes,8,opt,name=latency_threshold,json=latencyThreshold,proto3" json:"latency_threshold,omitempty"` // aimd, defaults to 500ms
+	BackoffRatio     float64              `protobuf:"fixed64,9,opt,name=backoff_ratio,json=backoffRatio,proto3" json:"backoff_ratio,omitempty"`           // aimd, defaults to 0.9
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Data_Redis) Reset() {
-	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+func (x *Server_Adaptive) Reset() {
+	*x = Server_Adaptive{}
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Redis) String() string {
+func (x *Server_Adaptive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Redis) ProtoMessage() {}
+func (*Server_Adaptive) ProtoMessage() {}
 
-func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+func (x *Server_Adaptive) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,88 +591,1264 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
-func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use Server_Adaptive.ProtoReflect.Descriptor instead.
+func (*Server_Adaptive) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 3}
 }
 
-func (x *Data_Redis) GetNetwork() string {
+func (x *Server_Adaptive) GetAlgorithm() string {
 	if x != nil {
-		return x.Network
+		return x.Algorithm
 	}
 	return ""
 }
 
-func (x *Data_Redis) GetAddr() string {
+func (x *Server_Adaptive) GetCpuThreshold() int32 {
 	if x != nil {
-		return x.Addr
+		return x.CpuThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+func (x *Server_Adaptive) GetWindow() *durationpb.Duration {
 	if x != nil {
-		return x.ReadTimeout
+		return x.Window
 	}
 	return nil
 }
 
-func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+func (x *Server_Adaptive) GetBuckets() int32 {
 	if x != nil {
-		return x.WriteTimeout
+		return x.Buckets
+	}
+	return 0
+}
+
+func (x *Server_Adaptive) GetInitialLimit() int32 {
+	if x != nil {
+		return x.InitialLimit
+	}
+	return 0
+}
+
+func (x *Server_Adaptive) GetMinLimit() int32 {
+	if x != nil {
+		return x.MinLimit
+	}
+	return 0
+}
+
+func (x *Server_Adaptive) GetMaxLimit() int32 {
+	if x != nil {
+		return x.MaxLimit
+	}
+	return 0
+}
+
+func (x *Server_Adaptive) GetLatencyThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.LatencyThreshold
 	}
 	return nil
 }
 
-func (x *Data_Redis) GetDb() int32 {
+func (x *Server_Adaptive) GetBackoffRatio() float64 {
 	if x != nil {
-		return x.Db
+		return x.BackoffRatio
 	}
 	return 0
 }
 
-func (x *Data_Redis) GetPassword() string {
+// /healthz, /readyz and grpc.health.v1
+type Server_Health struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"` // per dependency check, defaults to 1s
+	// on shutdown readiness fails this long before the servers stop, so load
+	// balancers stop routing first
+	DrainDelay    *durationpb.Duration `protobuf:"bytes,2,opt,name=drain_delay,json=drainDelay,proto3" json:"drain_delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Health) Reset() {
+	*x = Server_Health{}
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Health) ProtoMessage() {}
+
+func (x *Server_Health) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-var File_internal_conf_conf_proto protoreflect.FileDescriptor
+// Deprecated: Use Server_Health.ProtoReflect.Descriptor instead.
+func (*Server_Health) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 4}
+}
 
-const file_internal_conf_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x18internal/conf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"]\n" +
-	"\tBootstrap\x12*\n" +
-	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xb8\x02\n" +
-	"\x06Server\x12+\n" +
-	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
-	"\x04HTTP\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
-	"\x04GRPC\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xd6\x03\n" +
-	"\x04Data\x125\n" +
-	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a\x86\x01\n" +
-	"\bDatabase\x12\x16\n" +
-	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
-	"\x0emax_idle_conns\x18\x03 \x01(\x05R\fmaxIdleConns\x12$\n" +
-	"\x0emax_open_conns\x18\x04 \x01(\x05R\fmaxOpenConns\x1a\xdf\x01\n" +
-	"\x05Redis\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
-	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
-	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x0e\n" +
-	"\x02db\x18\x05 \x01(\x05R\x02db\x12\x1a\n" +
-	"\bpassword\x18\x06 \x01(\tR\bpasswordB\x1bZ\x19agdemo/internal/conf;confb\x06proto3"
+func (x *Server_Health) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server_Health) GetDrainDelay() *durationpb.Duration {
+	if x != nil {
+		return x.DrainDelay
+	}
+	return nil
+}
+
+// serves /metrics apart from the public listeners, without authentication;
+// bind it to an address only the monitoring network reaches. empty addr disables it
+type Server_Admin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Admin) Reset() {
+	*x = Server_Admin{}
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Admin) ProtoMessage() {}
+
+func (x *Server_Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Admin.ProtoReflect.Descriptor instead.
+func (*Server_Admin) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *Server_Admin) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_Admin) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+type Server_RateLimit_Quota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          int32                  `protobuf:"varint,1,opt,name=rate,proto3" json:"rate,omitempty"`   // tokens added per second
+	Burst         int32                  `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"` // bucket capacity, defaults to rate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_RateLimit_Quota) Reset() {
+	*x = Server_RateLimit_Quota{}
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_RateLimit_Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_RateLimit_Quota) ProtoMessage() {}
+
+func (x *Server_RateLimit_Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_RateLimit_Quota.ProtoReflect.Descriptor instead.
+func (*Server_RateLimit_Quota) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 2, 0}
+}
+
+func (x *Server_RateLimit_Quota) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Server_RateLimit_Quota) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type Server_RateLimit_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// operation name, or pattern ending in * such as /blog.v1.BlogService/*
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// what a bucket is kept per: caller (api key, else user, else client ip),
+	// api_key, user, ip or operation; the first three fall back to ip
+	Key           string                  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Quota         *Server_RateLimit_Quota `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_RateLimit_Rule) Reset() {
+	*x = Server_RateLimit_Rule{}
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_RateLimit_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_RateLimit_Rule) ProtoMessage() {}
+
+func (x *Server_RateLimit_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_RateLimit_Rule.ProtoReflect.Descriptor instead.
+func (*Server_RateLimit_Rule) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{1, 2, 1}
+}
+
+func (x *Server_RateLimit_Rule) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *Server_RateLimit_Rule) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Server_RateLimit_Rule) GetQuota() *Server_RateLimit_Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type Data_Database struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // mysql (default), postgres or sqlite
+	// driver specific DSN, e.g. for sqlite "file:agdemo.db?_pragma=busy_timeout(5000)" or ":memory:"
+	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	MaxIdleConns  int32  `protobuf:"varint,3,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
+	MaxOpenConns  int32  `protobuf:"varint,4,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
+	CheckSchema   bool   `protobuf:"varint,5,opt,name=check_schema,json=checkSchema,proto3" json:"check_schema,omitempty"` // refuse to start while migrations are pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Data_Database) GetMaxIdleConns() int32 {
+	if x != nil {
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetMaxOpenConns() int32 {
+	if x != nil {
+		return x.MaxOpenConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetCheckSchema() bool {
+	if x != nil {
+		return x.CheckSchema
+	}
+	return false
+}
+
+type Data_Redis struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Network      string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr         string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	ReadTimeout  *durationpb.Duration   `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout *durationpb.Duration   `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	Db           int32                  `protobuf:"varint,5,opt,name=db,proto3" json:"db,omitempty"`
+	Password     string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	// article cache: entries live cache_ttl plus a random [0, cache_ttl_jitter)
+	CacheTtl         *durationpb.Duration `protobuf:"bytes,7,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"`
+	CacheTtlJitter   *durationpb.Duration `protobuf:"bytes,8,opt,name=cache_ttl_jitter,json=cacheTtlJitter,proto3" json:"cache_ttl_jitter,omitempty"`
+	NegativeCacheTtl *durationpb.Duration `protobuf:"bytes,9,opt,name=negative_cache_ttl,json=negativeCacheTtl,proto3" json:"negative_cache_ttl,omitempty"` // how long a missing id is remembered
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Data_Redis) Reset() {
+	*x = Data_Redis{}
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis) ProtoMessage() {}
+
+func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
+func (*Data_Redis) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Data_Redis) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetDb() int32 {
+	if x != nil {
+		return x.Db
+	}
+	return 0
+}
+
+func (x *Data_Redis) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetCacheTtlJitter() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtlJitter
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetNegativeCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.NegativeCacheTtl
+	}
+	return nil
+}
+
+// write-behind of redis like counters into article.like_count
+type Data_LikeFlush struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_LikeFlush) Reset() {
+	*x = Data_LikeFlush{}
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_LikeFlush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_LikeFlush) ProtoMessage() {}
+
+func (x *Data_LikeFlush) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_LikeFlush.ProtoReflect.Descriptor instead.
+func (*Data_LikeFlush) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_LikeFlush) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_LikeFlush) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// purge of soft deleted articles
+type Data_Trash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retention     *durationpb.Duration   `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"` // how long deleted articles stay restorable
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`   // how often the purge runs
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	LockTtl       *durationpb.Duration   `protobuf:"bytes,4,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"` // one replica at a time purges, longer than a run takes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Trash) Reset() {
+	*x = Data_Trash{}
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Trash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Trash) ProtoMessage() {}
+
+func (x *Data_Trash) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Trash.ProtoReflect.Descriptor instead.
+func (*Data_Trash) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Trash) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Data_Trash) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_Trash) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Trash) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+// publishing of scheduled articles, one replica at a time holds the lock
+type Data_Publish struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	LockTtl       *durationpb.Duration   `protobuf:"bytes,3,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"` // longer than a run takes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Publish) Reset() {
+	*x = Data_Publish{}
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Publish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Publish) ProtoMessage() {}
+
+func (x *Data_Publish) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Publish.ProtoReflect.Descriptor instead.
+func (*Data_Publish) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Data_Publish) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Data_Publish) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Data_Publish) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+type Data_Search struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// memory (default) keeps an inverted index in each process, rebuilt every
+	// refresh_interval to pick up writes of other replicas; mysql uses a
+	// FULLTEXT index on the article table created by migration 0008, startup fails while it is missing
+	Driver          string               `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	RefreshInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"` // memory, defaults to 1m
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Search) Reset() {
+	*x = Data_Search{}
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Search) ProtoMessage() {}
+
+func (x *Data_Search) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Search.ProtoReflect.Descriptor instead.
+func (*Data_Search) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *Data_Search) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Search) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+type Data_Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxDepth      int32                  `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`       // deepest reply level, 0 is a top level comment
+	MaxReplies    int32                  `protobuf:"varint,2,opt,name=max_replies,json=maxReplies,proto3" json:"max_replies,omitempty"` // replies listed under each top level comment, defaults to 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Comment) Reset() {
+	*x = Data_Comment{}
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Comment) ProtoMessage() {}
+
+func (x *Data_Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Comment.ProtoReflect.Descriptor instead.
+func (*Data_Comment) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Data_Comment) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *Data_Comment) GetMaxReplies() int32 {
+	if x != nil {
+		return x.MaxReplies
+	}
+	return 0
+}
+
+// password hashing; hashes of the other kind, or with other costs, still
+// verify and are rehashed with these settings on the next login
+type Auth_Password struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hasher        string                 `protobuf:"bytes,1,opt,name=hasher,proto3" json:"hasher,omitempty"`                                     // bcrypt (default) or argon2id
+	BcryptCost    int32                  `protobuf:"varint,2,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`          // defaults to 10
+	Argon2Time    uint32                 `protobuf:"varint,3,opt,name=argon2_time,json=argon2Time,proto3" json:"argon2_time,omitempty"`          // iterations, defaults to 1
+	Argon2Memory  uint32                 `protobuf:"varint,4,opt,name=argon2_memory,json=argon2Memory,proto3" json:"argon2_memory,omitempty"`    // KiB, defaults to 65536
+	Argon2Threads uint32                 `protobuf:"varint,5,opt,name=argon2_threads,json=argon2Threads,proto3" json:"argon2_threads,omitempty"` // defaults to 4
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Password) Reset() {
+	*x = Auth_Password{}
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Password) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Password) ProtoMessage() {}
+
+func (x *Auth_Password) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Password.ProtoReflect.Descriptor instead.
+func (*Auth_Password) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Auth_Password) GetHasher() string {
+	if x != nil {
+		return x.Hasher
+	}
+	return ""
+}
+
+func (x *Auth_Password) GetBcryptCost() int32 {
+	if x != nil {
+		return x.BcryptCost
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetArgon2Time() uint32 {
+	if x != nil {
+		return x.Argon2Time
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetArgon2Memory() uint32 {
+	if x != nil {
+		return x.Argon2Memory
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetArgon2Threads() uint32 {
+	if x != nil {
+		return x.Argon2Threads
+	}
+	return 0
+}
+
+// bearer tokens, checked on both servers; without it every caller is anonymous
+type Auth_JWT struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HS256, RS256 or EdDSA; when empty no token is accepted and only public
+	// operations and api keys get through
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// HS256 shared secret, at least 32 bytes; prefer secret_env or secret_file
+	// over writing it into the config
+	Secret     string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	SecretEnv  string `protobuf:"bytes,11,opt,name=secret_env,json=secretEnv,proto3" json:"secret_env,omitempty"`    // HS256: environment variable holding the secret
+	SecretFile string `protobuf:"bytes,12,opt,name=secret_file,json=secretFile,proto3" json:"secret_file,omitempty"` // HS256: file holding the secret, surrounding whitespace is trimmed
+	// RS256 and EdDSA: JWKS document with the verification keys, picked by kid
+	JwksFile string `protobuf:"bytes,3,opt,name=jwks_file,json=jwksFile,proto3" json:"jwks_file,omitempty"`
+	// RS256 and EdDSA: PEM private key Login signs with, Login issues no token without it
+	PrivateKeyFile string               `protobuf:"bytes,4,opt,name=private_key_file,json=privateKeyFile,proto3" json:"private_key_file,omitempty"`
+	KeyId          string               `protobuf:"bytes,5,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`          // kid of issued tokens
+	Issuer         string               `protobuf:"bytes,6,opt,name=issuer,proto3" json:"issuer,omitempty"`                     // set on issued tokens and checked when not empty
+	Audience       string               `protobuf:"bytes,7,opt,name=audience,proto3" json:"audience,omitempty"`                 // set on issued tokens and checked when not empty
+	TokenTtl       *durationpb.Duration `protobuf:"bytes,8,opt,name=token_ttl,json=tokenTtl,proto3" json:"token_ttl,omitempty"` // defaults to 1h
+	Leeway         *durationpb.Duration `protobuf:"bytes,9,opt,name=leeway,proto3" json:"leeway,omitempty"`                     // allowed clock skew
+	// operations callable without a token, e.g. /blog.v1.BlogService/GetArticle;
+	// /blog.v1.UserService/* matches every operation of the service
+	PublicOperations []string `protobuf:"bytes,10,rep,name=public_operations,json=publicOperations,proto3" json:"public_operations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Auth_JWT) Reset() {
+	*x = Auth_JWT{}
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_JWT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_JWT) ProtoMessage() {}
+
+func (x *Auth_JWT) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_JWT.ProtoReflect.Descriptor instead.
+func (*Auth_JWT) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Auth_JWT) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetSecretEnv() string {
+	if x != nil {
+		return x.SecretEnv
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetSecretFile() string {
+	if x != nil {
+		return x.SecretFile
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetJwksFile() string {
+	if x != nil {
+		return x.JwksFile
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetPrivateKeyFile() string {
+	if x != nil {
+		return x.PrivateKeyFile
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *Auth_JWT) GetTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.TokenTtl
+	}
+	return nil
+}
+
+func (x *Auth_JWT) GetLeeway() *durationpb.Duration {
+	if x != nil {
+		return x.Leeway
+	}
+	return nil
+}
+
+func (x *Auth_JWT) GetPublicOperations() []string {
+	if x != nil {
+		return x.PublicOperations
+	}
+	return nil
+}
+
+// role based access control of authenticated callers, public operations
+// of jwt are open to everyone; without roles every caller may do everything
+type Auth_Authz struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Auth_Authz_Role     `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	DefaultRole   string                 `protobuf:"bytes,2,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"` // role of newly registered users, defaults to author
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Authz) Reset() {
+	*x = Auth_Authz{}
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Authz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Authz) ProtoMessage() {}
+
+func (x *Auth_Authz) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Authz.ProtoReflect.Descriptor instead.
+func (*Auth_Authz) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Auth_Authz) GetRoles() []*Auth_Authz_Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Auth_Authz) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
+}
+
+type Auth_Authz_Role struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// operations the role may call, "*" suffix matches by prefix, e.g. /blog.v1.BlogService/*
+	Operations []string `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	// operations the role may only call on articles the caller wrote
+	OwnOperations []string `protobuf:"bytes,3,rep,name=own_operations,json=ownOperations,proto3" json:"own_operations,omitempty"`
+	// may list unpublished and trashed articles of every author, others only
+	// see their own
+	ReadUnpublished bool `protobuf:"varint,4,opt,name=read_unpublished,json=readUnpublished,proto3" json:"read_unpublished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Auth_Authz_Role) Reset() {
+	*x = Auth_Authz_Role{}
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Authz_Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Authz_Role) ProtoMessage() {}
+
+func (x *Auth_Authz_Role) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Authz_Role.ProtoReflect.Descriptor instead.
+func (*Auth_Authz_Role) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3, 2, 0}
+}
+
+func (x *Auth_Authz_Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Auth_Authz_Role) GetOperations() []string {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *Auth_Authz_Role) GetOwnOperations() []string {
+	if x != nil {
+		return x.OwnOperations
+	}
+	return nil
+}
+
+func (x *Auth_Authz_Role) GetReadUnpublished() bool {
+	if x != nil {
+		return x.ReadUnpublished
+	}
+	return false
+}
+
+var File_internal_conf_conf_proto protoreflect.FileDescriptor
+
+const file_internal_conf_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x18internal/conf/conf.proto\x12\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x83\x01\n" +
+	"\tBootstrap\x12*\n" +
+	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12$\n" +
+	"\x04auth\x18\x03 \x01(\v2\x10.kratos.api.AuthR\x04auth\"\xe9\f\n" +
+	"\x06Server\x12+\n" +
+	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12;\n" +
+	"\n" +
+	"rate_limit\x18\x03 \x01(\v2\x1c.kratos.api.Server.RateLimitR\trateLimit\x127\n" +
+	"\badaptive\x18\x04 \x01(\v2\x1b.kratos.api.Server.AdaptiveR\badaptive\x121\n" +
+	"\x06health\x18\x05 \x01(\v2\x19.kratos.api.Server.HealthR\x06health\x12.\n" +
+	"\x05admin\x18\x06 \x01(\v2\x18.kratos.api.Server.AdminR\x05admin\x1ai\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\x04GRPC\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xba\x04\n" +
+	"\tRateLimit\x127\n" +
+	"\x05rules\x18\x01 \x03(\v2!.kratos.api.Server.RateLimit.RuleR\x05rules\x12G\n" +
+	"\rdefault_quota\x18\x02 \x01(\v2\".kratos.api.Server.RateLimit.QuotaR\fdefaultQuota\x12\x1f\n" +
+	"\vmax_buckets\x18\x03 \x01(\x05R\n" +
+	"maxBuckets\x12.\n" +
+	"\x13trust_forwarded_for\x18\x04 \x01(\bR\x11trustForwardedFor\x12\x18\n" +
+	"\abackend\x18\x05 \x01(\tR\abackend\x12\x1c\n" +
+	"\talgorithm\x18\x06 \x01(\tR\talgorithm\x12>\n" +
+	"\rredis_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fredisTimeout\x12=\n" +
+	"\bpre_auth\x18\b \x01(\v2\".kratos.api.Server.RateLimit.QuotaR\apreAuth\x1a1\n" +
+	"\x05Quota\x12\x12\n" +
+	"\x04rate\x18\x01 \x01(\x05R\x04rate\x12\x14\n" +
+	"\x05burst\x18\x02 \x01(\x05R\x05burst\x1ap\n" +
+	"\x04Rule\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x128\n" +
+	"\x05quota\x18\x03 \x01(\v2\".kratos.api.Server.RateLimit.QuotaR\x05quota\x1a\xe6\x02\n" +
+	"\bAdaptive\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12#\n" +
+	"\rcpu_threshold\x18\x02 \x01(\x05R\fcpuThreshold\x121\n" +
+	"\x06window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12\x18\n" +
+	"\abuckets\x18\x04 \x01(\x05R\abuckets\x12#\n" +
+	"\rinitial_limit\x18\x05 \x01(\x05R\finitialLimit\x12\x1b\n" +
+	"\tmin_limit\x18\x06 \x01(\x05R\bminLimit\x12\x1b\n" +
+	"\tmax_limit\x18\a \x01(\x05R\bmaxLimit\x12F\n" +
+	"\x11latency_threshold\x18\b \x01(\v2\x19.google.protobuf.DurationR\x10latencyThreshold\x12#\n" +
+	"\rbackoff_ratio\x18\t \x01(\x01R\fbackoffRatio\x1ay\n" +
+	"\x06Health\x123\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12:\n" +
+	"\vdrain_delay\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"drainDelay\x1a5\n" +
+	"\x05Admin\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\"\xbc\f\n" +
+	"\x04Data\x125\n" +
+	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x129\n" +
+	"\n" +
+	"like_flush\x18\x03 \x01(\v2\x1a.kratos.api.Data.LikeFlushR\tlikeFlush\x12,\n" +
+	"\x05trash\x18\x04 \x01(\v2\x16.kratos.api.Data.TrashR\x05trash\x122\n" +
+	"\apublish\x18\x05 \x01(\v2\x18.kratos.api.Data.PublishR\apublish\x12/\n" +
+	"\x06search\x18\x06 \x01(\v2\x17.kratos.api.Data.SearchR\x06search\x122\n" +
+	"\acomment\x18\a \x01(\v2\x18.kratos.api.Data.CommentR\acomment\x1a\xa9\x01\n" +
+	"\bDatabase\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
+	"\x0emax_idle_conns\x18\x03 \x01(\x05R\fmaxIdleConns\x12$\n" +
+	"\x0emax_open_conns\x18\x04 \x01(\x05R\fmaxOpenConns\x12!\n" +
+	"\fcheck_schema\x18\x05 \x01(\bR\vcheckSchema\x1a\xa5\x03\n" +
+	"\x05Redis\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
+	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x0e\n" +
+	"\x02db\x18\x05 \x01(\x05R\x02db\x12\x1a\n" +
+	"\bpassword\x18\x06 \x01(\tR\bpassword\x126\n" +
+	"\tcache_ttl\x18\a \x01(\v2\x19.google.protobuf.DurationR\bcacheTtl\x12C\n" +
+	"\x10cache_ttl_jitter\x18\b \x01(\v2\x19.google.protobuf.DurationR\x0ecacheTtlJitter\x12G\n" +
+	"\x12negative_cache_ttl\x18\t \x01(\v2\x19.google.protobuf.DurationR\x10negativeCacheTtl\x1aa\n" +
+	"\tLikeFlush\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x1a\xcc\x01\n" +
+	"\x05Trash\x127\n" +
+	"\tretention\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tretention\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x124\n" +
+	"\block_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x1a\x95\x01\n" +
+	"\aPublish\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\x124\n" +
+	"\block_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\alockTtl\x1af\n" +
+	"\x06Search\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12D\n" +
+	"\x10refresh_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x1aG\n" +
+	"\aComment\x12\x1b\n" +
+	"\tmax_depth\x18\x01 \x01(\x05R\bmaxDepth\x12\x1f\n" +
+	"\vmax_replies\x18\x02 \x01(\x05R\n" +
+	"maxReplies\"\xdd\a\n" +
+	"\x04Auth\x125\n" +
+	"\bpassword\x18\x01 \x01(\v2\x19.kratos.api.Auth.PasswordR\bpassword\x12&\n" +
+	"\x03jwt\x18\x02 \x01(\v2\x14.kratos.api.Auth.JWTR\x03jwt\x12,\n" +
+	"\x05authz\x18\x03 \x01(\v2\x16.kratos.api.Auth.AuthzR\x05authz\x1a\xb0\x01\n" +
+	"\bPassword\x12\x16\n" +
+	"\x06hasher\x18\x01 \x01(\tR\x06hasher\x12\x1f\n" +
+	"\vbcrypt_cost\x18\x02 \x01(\x05R\n" +
+	"bcryptCost\x12\x1f\n" +
+	"\vargon2_time\x18\x03 \x01(\rR\n" +
+	"argon2Time\x12#\n" +
+	"\rargon2_memory\x18\x04 \x01(\rR\fargon2Memory\x12%\n" +
+	"\x0eargon2_threads\x18\x05 \x01(\rR\rargon2Threads\x1a\xa5\x03\n" +
+	"\x03JWT\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"secret_env\x18\v \x01(\tR\tsecretEnv\x12\x1f\n" +
+	"\vsecret_file\x18\f \x01(\tR\n" +
+	"secretFile\x12\x1b\n" +
+	"\tjwks_file\x18\x03 \x01(\tR\bjwksFile\x12(\n" +
+	"\x10private_key_file\x18\x04 \x01(\tR\x0eprivateKeyFile\x12\x15\n" +
+	"\x06key_id\x18\x05 \x01(\tR\x05keyId\x12\x16\n" +
+	"\x06issuer\x18\x06 \x01(\tR\x06issuer\x12\x1a\n" +
+	"\baudience\x18\a \x01(\tR\baudience\x126\n" +
+	"\ttoken_ttl\x18\b \x01(\v2\x19.google.protobuf.DurationR\btokenTtl\x121\n" +
+	"\x06leeway\x18\t \x01(\v2\x19.google.protobuf.DurationR\x06leeway\x12+\n" +
+	"\x11public_operations\x18\n" +
+	" \x03(\tR\x10publicOperations\x1a\xec\x01\n" +
+	"\x05Authz\x121\n" +
+	"\x05roles\x18\x01 \x03(\v2\x1b.kratos.api.Auth.Authz.RoleR\x05roles\x12!\n" +
+	"\fdefault_role\x18\x02 \x01(\tR\vdefaultRole\x1a\x8c\x01\n" +
+	"\x04Role\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"operations\x18\x02 \x03(\tR\n" +
+	"operations\x12%\n" +
+	"\x0eown_operations\x18\x03 \x03(\tR\rownOperations\x12)\n" +
+	"\x10read_unpublished\x18\x04 \x01(\bR\x0freadUnpublishedB\x1bZ\x19agdemo/internal/conf;confb\x06proto3"
 
 var (
 	file_internal_conf_conf_proto_rawDescOnce sync.Once
@@ -498,33 +1862,84 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_internal_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Server_HTTP)(nil),         // 3: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 4: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 5: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 6: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(*Bootstrap)(nil),              // 0: kratos.api.Bootstrap
+	(*Server)(nil),                 // 1: kratos.api.Server
+	(*Data)(nil),                   // 2: kratos.api.Data
+	(*Auth)(nil),                   // 3: kratos.api.Auth
+	(*Server_HTTP)(nil),            // 4: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),            // 5: kratos.api.Server.GRPC
+	(*Server_RateLimit)(nil),       // 6: kratos.api.Server.RateLimit
+	(*Server_Adaptive)(nil),        // 7: kratos.api.Server.Adaptive
+	(*Server_Health)(nil),          // 8: kratos.api.Server.Health
+	(*Server_Admin)(nil),           // 9: kratos.api.Server.Admin
+	(*Server_RateLimit_Quota)(nil), // 10: kratos.api.Server.RateLimit.Quota
+	(*Server_RateLimit_Rule)(nil),  // 11: kratos.api.Server.RateLimit.Rule
+	(*Data_Database)(nil),          // 12: kratos.api.Data.Database
+	(*Data_Redis)(nil),             // 13: kratos.api.Data.Redis
+	(*Data_LikeFlush)(nil),         // 14: kratos.api.Data.LikeFlush
+	(*Data_Trash)(nil),             // 15: kratos.api.Data.Trash
+	(*Data_Publish)(nil),           // 16: kratos.api.Data.Publish
+	(*Data_Search)(nil),            // 17: kratos.api.Data.Search
+	(*Data_Comment)(nil),           // 18: kratos.api.Data.Comment
+	(*Auth_Password)(nil),          // 19: kratos.api.Auth.Password
+	(*Auth_JWT)(nil),               // 20: kratos.api.Auth.JWT
+	(*Auth_Authz)(nil),             // 21: kratos.api.Auth.Authz
+	(*Auth_Authz_Role)(nil),        // 22: kratos.api.Auth.Authz.Role
+	(*durationpb.Duration)(nil),    // 23: google.protobuf.Duration
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	3,  // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	4,  // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	5,  // 4: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	6,  // 5: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	7,  // 6: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	7,  // 7: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	7,  // 8: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	7,  // 9: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	3,  // 2: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
+	4,  // 3: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	5,  // 4: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	6,  // 5: kratos.api.Server.rate_limit:type_name -> kratos.api.Server.RateLimit
+	7,  // 6: kratos.api.Server.adaptive:type_name -> kratos.api.Server.Adaptive
+	8,  // 7: kratos.api.Server.health:type_name -> kratos.api.Server.Health
+	9,  // 8: kratos.api.Server.admin:type_name -> kratos.api.Server.Admin
+	12, // 9: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	13, // 10: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	14, // 11: kratos.api.Data.like_flush:type_name -> kratos.api.Data.LikeFlush
+	15, // 12: kratos.api.Data.trash:type_name -> kratos.api.Data.Trash
+	16, // 13: kratos.api.Data.publish:type_name -> kratos.api.Data.Publish
+	17, // 14: kratos.api.Data.search:type_name -> kratos.api.Data.Search
+	18, // 15: kratos.api.Data.comment:type_name -> kratos.api.Data.Comment
+	19, // 16: kratos.api.Auth.password:type_name -> kratos.api.Auth.Password
+	20, // 17: kratos.api.Auth.jwt:type_name -> kratos.api.Auth.JWT
+	21, // 18: kratos.api.Auth.authz:type_name -> kratos.api.Auth.Authz
+	23, // 19: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	23, // 20: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	11, // 21: kratos.api.Server.RateLimit.rules:type_name -> kratos.api.Server.RateLimit.Rule
+	10, // 22: kratos.api.Server.RateLimit.default_quota:type_name -> kratos.api.Server.RateLimit.Quota
+	23, // 23: kratos.api.Server.RateLimit.redis_timeout:type_name -> google.protobuf.Duration
+	10, // 24: kratos.api.Server.RateLimit.pre_auth:type_name -> kratos.api.Server.RateLimit.Quota
+	23, // 25: kratos.api.Server.Adaptive.window:type_name -> google.protobuf.Duration
+	23, // 26: kratos.api.Server.Adaptive.latency_threshold:type_name -> google.protobuf.Duration
+	23, // 27: kratos.api.Server.Health.timeout:type_name -> google.protobuf.Duration
+	23, // 28: kratos.api.Server.Health.drain_delay:type_name -> google.protobuf.Duration
+	10, // 29: kratos.api.Server.RateLimit.Rule.quota:type_name -> kratos.api.Server.RateLimit.Quota
+	23, // 30: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	23, // 31: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	23, // 32: kratos.api.Data.Redis.cache_ttl:type_name -> google.protobuf.Duration
+	23, // 33: kratos.api.Data.Redis.cache_ttl_jitter:type_name -> google.protobuf.Duration
+	23, // 34: kratos.api.Data.Redis.negative_cache_ttl:type_name -> google.protobuf.Duration
+	23, // 35: kratos.api.Data.LikeFlush.interval:type_name -> google.protobuf.Duration
+	23, // 36: kratos.api.Data.Trash.retention:type_name -> google.protobuf.Duration
+	23, // 37: kratos.api.Data.Trash.interval:type_name -> google.protobuf.Duration
+	23, // 38: kratos.api.Data.Trash.lock_ttl:type_name -> google.protobuf.Duration
+	23, // 39: kratos.api.Data.Publish.interval:type_name -> google.protobuf.Duration
+	23, // 40: kratos.api.Data.Publish.lock_ttl:type_name -> google.protobuf.Duration
+	23, // 41: kratos.api.Data.Search.refresh_interval:type_name -> google.protobuf.Duration
+	23, // 42: kratos.api.Auth.JWT.token_ttl:type_name -> google.protobuf.Duration
+	23, // 43: kratos.api.Auth.JWT.leeway:type_name -> google.protobuf.Duration
+	22, // 44: kratos.api.Auth.Authz.roles:type_name -> kratos.api.Auth.Authz.Role
+	45, // [45:45] is the sub-list for method output_type
+	45, // [45:45] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -538,7 +1953,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
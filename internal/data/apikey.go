@@ -0,0 +1,129 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type apiKey struct {
+	Id         int64      `gorm:"primaryKey"`
+	Name       string     `gorm:"size:64"`
+	Prefix     string     `gorm:"size:16"`
+	KeyHash    string     `gorm:"column:key_hash;size:64"`
+	Scopes     string     `gorm:"size:4000"` // 换行分隔
+	CreatedBy  int64      `gorm:"column:created_by"`
+	CreatedAt  time.Time  `gorm:"column:created_at"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+}
+
+func (apiKey) TableName() string {
+	return "api_key"
+}
+
+func (k *apiKey) toDomain() *biz.ApiKey {
+	var scopes []string
+	if k.Scopes != "" {
+		scopes = strings.Split(k.Scopes, "\n")
+	}
+	return &biz.ApiKey{
+		Id:         k.Id,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		KeyHash:    k.KeyHash,
+		Scopes:     scopes,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  timeValue(k.ExpiresAt),
+		LastUsedAt: timeValue(k.LastUsedAt),
+		RevokedAt:  timeValue(k.RevokedAt),
+	}
+}
+
+type apiKeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewApiKeyRepo(data *Data, logger log.Logger) biz.ApiKeyRepo {
+	return &apiKeyRepo{data: data, log: log.NewHelper(logger)}
+}
+
+func (r *apiKeyRepo) CreateApiKey(ctx context.Context, k *biz.ApiKey) error {
+	model := &apiKey{
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		KeyHash:   k.KeyHash,
+		Scopes:    strings.Join(k.Scopes, "\n"),
+		CreatedBy: k.CreatedBy,
+		ExpiresAt: timePtr(k.ExpiresAt),
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicate(err, ukApiKeyPrefix) {
+			return biz.ErrApiKeyPrefixTaken
+		}
+		r.log.Errorf("CreateApiKey error: %v", err)
+		return err
+	}
+	k.Id = model.Id
+	k.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *apiKeyRepo) get(db *gorm.DB, query string, arg interface{}) (*biz.ApiKey, error) {
+	var model apiKey
+	if err := db.Where(query, arg).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrApiKeyNotFound
+		}
+		r.log.Errorf("GetApiKey error: %v", err)
+		return nil, err
+	}
+	return model.toDomain(), nil
+}
+
+func (r *apiKeyRepo) GetApiKeyByPrefix(ctx context.Context, prefix string) (*biz.ApiKey, error) {
+	return r.get(r.data.db.WithContext(ctx), "prefix = ?", prefix)
+}
+
+func (r *apiKeyRepo) ListApiKeys(ctx context.Context, before int64, limit int, includeRevoked bool) ([]*biz.ApiKey, error) {
+	db := r.data.db.WithContext(ctx)
+	if before > 0 {
+		db = db.Where("id < ?", before)
+	}
+	if !includeRevoked {
+		db = db.Where("revoked_at IS NULL")
+	}
+	var list []*apiKey
+	if err := db.Order("id DESC").Limit(limit).Find(&list).Error; err != nil {
+		r.log.Errorf("ListApiKeys error: %v", err)
+		return nil, err
+	}
+	res := make([]*biz.ApiKey, 0, len(list))
+	for _, k := range list {
+		res = append(res, k.toDomain())
+	}
+	return res, nil
+}
+
+func (r *apiKeyRepo) RevokeApiKey(ctx context.Context, id int64, t time.Time) (*biz.ApiKey, error) {
+	db := r.data.db.WithContext(ctx)
+	err := db.Model(&apiKey{}).Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", t).Error
+	if err != nil {
+		r.log.Errorf("RevokeApiKey error: %v", err)
+		return nil, err
+	}
+	return r.get(db, "id = ?", id)
+}
+
+func (r *apiKeyRepo) TouchApiKey(ctx context.Context, id int64, t time.Time) error {
+	return r.data.db.WithContext(ctx).Model(&apiKey{}).Where("id = ?", id).
+		UpdateColumn("last_used_at", t).Error
+}
@@ -0,0 +1,141 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// collidingRepo 第一次写入时换成已存在的前缀，模拟前缀冲突
+type collidingRepo struct {
+	biz.ApiKeyRepo
+	prefix string
+	calls  int
+}
+
+func (r *collidingRepo) CreateApiKey(ctx context.Context, k *biz.ApiKey) error {
+	r.calls++
+	if r.calls == 1 {
+		k.Prefix = r.prefix
+	}
+	return r.ApiKeyRepo.CreateApiKey(ctx, k)
+}
+
+func newTestApiKeys(t *testing.T) (*biz.ApiKeyUsecase, biz.ApiKeyRepo) {
+	t.Helper()
+	d, _, _ := newTestData(t)
+	repo := NewApiKeyRepo(d, log.DefaultLogger)
+	return biz.NewApiKeyUsecase(repo, log.DefaultLogger), repo
+}
+
+var apiKeyPattern = regexp.MustCompile(`^agk_([0-9a-f]{16})_[A-Za-z0-9_-]{32}$`)
+
+func TestApiKeyMint(t *testing.T) {
+	uc, repo := newTestApiKeys(t)
+	if _, err := uc.Create(context.Background(), &biz.ApiKey{Name: "ci"}, 0); !biz.ErrUnauthenticated.Is(err) {
+		t.Fatalf("anonymous create err = %v", err)
+	}
+	if _, err := uc.Create(authorContext(1), &biz.ApiKey{Name: "ci", Scopes: []string{"/a*b"}}, 0); !biz.ErrInvalidScope.Is(err) {
+		t.Fatalf("invalid scope err = %v", err)
+	}
+
+	k := &biz.ApiKey{Name: "ci", Scopes: []string{"/blog.v1.BlogService/*"}}
+	key, err := uc.Create(authorContext(1), k, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := apiKeyPattern.FindStringSubmatch(key)
+	if m == nil {
+		t.Fatalf("key %q has an unexpected format", key)
+	}
+	stored, err := repo.GetApiKeyByPrefix(context.Background(), m[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte(key))
+	if stored.KeyHash != hex.EncodeToString(sum[:]) || strings.Contains(stored.KeyHash, key) {
+		t.Fatalf("stored hash %q is not the sha256 of the key", stored.KeyHash)
+	}
+	if stored.CreatedBy != 1 || stored.Name != "ci" || len(stored.Scopes) != 1 || stored.ExpiresAt.IsZero() {
+		t.Fatalf("stored key = %+v", stored)
+	}
+
+	// 前缀冲突时重新生成
+	colliding := &collidingRepo{ApiKeyRepo: repo, prefix: m[1]}
+	uc = biz.NewApiKeyUsecase(colliding, log.DefaultLogger)
+	second, err := uc.Create(authorContext(1), &biz.ApiKey{Name: "retry"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if colliding.calls != 2 || second == key {
+		t.Fatalf("calls %d, key %q", colliding.calls, second)
+	}
+	if _, err = uc.Authenticate(context.Background(), second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestApiKeyAuthenticate(t *testing.T) {
+	uc, repo := newTestApiKeys(t)
+	ctx := context.Background()
+	key, err := uc.Create(authorContext(1), &biz.ApiKey{Name: "ci"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := uc.Create(authorContext(1), &biz.ApiKey{Name: "old"}, time.Nanosecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+
+	k, err := uc.Authenticate(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := repo.GetApiKeyByPrefix(ctx, k.Prefix); got.LastUsedAt.IsZero() {
+		t.Fatal("last_used_at not recorded")
+	}
+	for name, bad := range map[string]string{
+		"wrong secret":   key[:len(key)-4] + "AAAA",
+		"unknown prefix": "agk_0000000000000000_" + key[len(key)-32:],
+		"malformed":      "agk__x",
+		"other tag":      strings.Replace(key, "agk_", "xyz_", 1),
+		"expired":        expired,
+	} {
+		if _, err = uc.Authenticate(ctx, bad); !biz.ErrInvalidApiKey.Is(err) {
+			t.Errorf("%s: err = %v", name, err)
+		}
+	}
+
+	revoked, err := uc.Revoke(ctx, k.Id)
+	if err != nil || revoked.RevokedAt.IsZero() {
+		t.Fatalf("Revoke = %+v, %v", revoked, err)
+	}
+	// 重复撤销保留第一次的时间
+	again, err := uc.Revoke(ctx, k.Id)
+	if err != nil || !again.RevokedAt.Equal(revoked.RevokedAt) {
+		t.Fatalf("revoke again = %+v, %v", again, err)
+	}
+	if _, err = uc.Authenticate(ctx, key); !biz.ErrInvalidApiKey.Is(err) {
+		t.Fatalf("revoked key err = %v", err)
+	}
+	if _, err = uc.Revoke(ctx, 404); !biz.ErrApiKeyNotFound.Is(err) {
+		t.Fatalf("revoke missing key err = %v", err)
+	}
+
+	page, err := uc.List(ctx, 10, "", false)
+	if err != nil || len(page.Keys) != 1 || page.Keys[0].Name != "old" {
+		t.Fatalf("List = %+v, %v", page, err)
+	}
+	page, err = uc.List(ctx, 1, "", true)
+	if err != nil || len(page.Keys) != 1 || page.NextPageToken == "" {
+		t.Fatalf("List with revoked = %+v, %v", page, err)
+	}
+}
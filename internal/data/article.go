@@ -2,20 +2,40 @@ package data
 
 import (
 	"agdemo/internal/biz"
+	"agdemo/internal/conf"
 	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"strings"
 	"time"
 )
 
 // data/article.go
 // 将数据库模型改为私有（首字母小写）
 type article struct { // 注意首字母小写
-	Id        int64     `gorm:"primaryKey"`
-	Title     string    `gorm:"size:100"`
-	Content   string    `gorm:"type:text"`
-	LikeCount int64     `gorm:"column:like_count"`
-	CreatedAt time.Time `gorm:"column:created_at"`
-	UpdatedAt time.Time `gorm:"column:updated_at"`
+	Id        int64          `gorm:"primaryKey"`
+	Title     string         `gorm:"size:100"`
+	Content   string         `gorm:"type:text"`
+	LikeCount int64          `gorm:"column:like_count"`
+	ViewCount int64          `gorm:"column:view_count"` // 由 redis 计数定期回写
+	Version   int64          `gorm:"column:version"`
+	CreatedAt time.Time      `gorm:"column:created_at"`
+	UpdatedAt time.Time      `gorm:"column:updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at"` // 软删除，默认查询自动排除
+
+	Status      string     `gorm:"size:16"`
+	PublishAt   *time.Time `gorm:"column:publish_at"`
+	PublishedAt *time.Time `gorm:"column:published_at"`
+	CategoryId  *int64     `gorm:"column:category_id"`
+
+	CommentCount int64 `gorm:"column:comment_count"` // 由评论审核和删除维护
+
+	AuthorId int64          `gorm:"column:author_id"`
+	Author   *articleAuthor `gorm:"-"` // 存于 users，随模型一起缓存
+
+	Tags []string `gorm:"-"` // 存于 article_tag，随模型一起缓存
 }
 
 // 实现TableName接口（可选）
@@ -24,8 +44,9 @@ func (article) TableName() string {
 }
 
 type articleRepo struct {
-	data *Data
-	log  *log.Helper
+	data  *Data
+	cache *articleCache
+	log   *log.Helper
 }
 
 // data/article.go
@@ -36,8 +57,23 @@ func (r *articleRepo) toDomain(a *article) *biz.Article {
 		Title:     a.Title,
 		Content:   a.Content,
 		Like:      a.LikeCount,
+		Version:   a.Version,
 		CreatedAt: a.CreatedAt,
 		UpdatedAt: a.UpdatedAt,
+		DeletedAt: a.DeletedAt.Time,
+
+		Status:      biz.ArticleStatus(a.Status),
+		PublishAt:   timeValue(a.PublishAt),
+		PublishedAt: timeValue(a.PublishedAt),
+
+		Tags:       a.Tags,
+		CategoryId: int64Value(a.CategoryId),
+
+		CommentCount: a.CommentCount,
+		Views:        a.ViewCount,
+
+		AuthorId: a.AuthorId,
+		Author:   r.toAuthor(a.Author),
 	}
 }
 
@@ -47,25 +83,150 @@ func (r *articleRepo) toModel(a *biz.Article) *article {
 		Title:     a.Title,
 		Content:   a.Content,
 		LikeCount: a.Like,
+		Version:   a.Version,
 		CreatedAt: a.CreatedAt,
 		UpdatedAt: a.UpdatedAt,
+
+		Status:      string(a.Status),
+		PublishAt:   timePtr(a.PublishAt),
+		PublishedAt: timePtr(a.PublishedAt),
+		CategoryId:  int64Ptr(a.CategoryId),
+		Tags:        a.Tags,
+		AuthorId:    a.AuthorId,
+	}
+}
+
+func (r *articleRepo) toAuthor(a *articleAuthor) *biz.User {
+	if a == nil {
+		return nil
+	}
+	return &biz.User{Id: a.Id, Username: a.Username, DisplayName: a.DisplayName}
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+// timePtr 零值映射为 NULL
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func int64Ptr(v int64) *int64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+// translateErr 将 gorm 错误转换为业务错误，其余错误原样返回
+func translateErr(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return biz.ErrArticleNotFound
 	}
+	return err
 }
 
-func NewArticleRepo(data *Data, logger log.Logger) biz.ArticleRepo {
+func NewArticleRepo(c *conf.Data, data *Data, logger log.Logger) biz.ArticleRepo {
 	return &articleRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:  data,
+		cache: data.articles,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern, '!' works as ESCAPE on every SQL dialect
+var likeEscaper = strings.NewReplacer(`!`, `!!`, `%`, `!%`, `_`, `!_`)
+
+func (r *articleRepo) filter(db *gorm.DB, f *biz.ArticleFilter) *gorm.DB {
+	if f.Deleted {
+		db = db.Unscoped().Where("deleted_at IS NOT NULL")
+	}
+	if f.Status != "" {
+		db = db.Where("status = ?", string(f.Status))
+	}
+	if f.Tag != "" {
+		// 子查询从 db 派生新会话，沿用请求的 ctx
+		db = db.Where("id IN (?)", db.Session(&gorm.Session{NewDB: true}).Table("article_tag").
+			Select("article_tag.article_id").
+			Joins("JOIN tag ON tag.id = article_tag.tag_id").
+			Where("tag.name = ?", f.Tag))
+	}
+	if f.CategoryId > 0 {
+		db = db.Where("category_id = ?", f.CategoryId)
+	}
+	if f.AuthorId > 0 {
+		db = db.Where("author_id = ?", f.AuthorId)
+	}
+	if f.Title != "" {
+		db = db.Where("title LIKE ? ESCAPE '!'", "%"+likeEscaper.Replace(f.Title)+"%")
+	}
+	if !f.CreatedAfter.IsZero() {
+		db = db.Where("created_at >= ?", f.CreatedAfter)
+	}
+	if !f.CreatedBefore.IsZero() {
+		db = db.Where("created_at < ?", f.CreatedBefore)
 	}
+	return db
 }
 
-func (r *articleRepo) ListArticle(ctx context.Context) ([]*biz.Article, error) {
+// cursorValue 取出游标中与排序列对应的值
+func cursorValue(field biz.ArticleOrderField, c *biz.ArticleCursor) interface{} {
+	switch field {
+	case biz.ArticleOrderByUpdatedAt:
+		return c.UpdatedAt
+	case biz.ArticleOrderByLikeCount:
+		return c.Like
+	case biz.ArticleOrderByDeletedAt:
+		return c.DeletedAt
+	default:
+		return c.CreatedAt
+	}
+}
+
+func (r *articleRepo) ListArticle(ctx context.Context, opt *biz.ArticleListOption) ([]*biz.Article, error) {
+	col, dir, cmp := string(opt.OrderBy), "ASC", ">"
+	if opt.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	db := r.filter(r.data.db.WithContext(ctx), &opt.Filter)
+	if opt.After != nil {
+		// keyset 分页：(col, id) 严格位于游标之后
+		v := cursorValue(opt.OrderBy, opt.After)
+		db = db.Where(fmt.Sprintf("%s %s ? OR (%s = ? AND id %s ?)", col, cmp, col, cmp), v, v, opt.After.Id)
+	}
+
 	var list []*article
-	if err := r.data.db.WithContext(ctx).Find(&list).Error; err != nil {
+	err := db.Order(fmt.Sprintf("%s %s, id %s", col, dir, dir)).Limit(opt.Limit).Find(&list).Error
+	if err != nil {
 		r.log.Errorf("List error: %v", err)
 		return nil, err
 	}
 
+	if err = loadTags(r.data.db.WithContext(ctx), list...); err != nil {
+		r.log.Errorf("List tags error: %v", err)
+		return nil, err
+	}
+	if err = loadAuthors(r.data.db.WithContext(ctx), list...); err != nil {
+		r.log.Errorf("List authors error: %v", err)
+		return nil, err
+	}
+
 	result := make([]*biz.Article, 0, len(list))
 	for _, item := range list {
 		result = append(result, r.toDomain(item))
@@ -73,37 +234,312 @@ func (r *articleRepo) ListArticle(ctx context.Context) ([]*biz.Article, error) {
 	return result, nil
 }
 
+func (r *articleRepo) CountArticle(ctx context.Context, f *biz.ArticleFilter) (int64, error) {
+	var total int64
+	if err := r.filter(r.data.db.WithContext(ctx).Model(&article{}), f).Count(&total).Error; err != nil {
+		r.log.Errorf("Count error: %v", err)
+		return 0, err
+	}
+	return total, nil
+}
+
 func (r *articleRepo) GetArticle(ctx context.Context, id int64) (*biz.Article, error) {
-	var a article
-	if err := r.data.db.WithContext(ctx).First(&a, id).Error; err != nil {
-		r.log.Errorf("Get error: %v", err)
-		return nil, err
+	if a, ok := r.cache.get(ctx, id); ok {
+		if a == nil {
+			return nil, biz.ErrArticleNotFound
+		}
+		return r.toDomain(a), nil
+	}
+
+	a, err := r.cache.load(ctx, id, func(ctx context.Context) (*article, error) {
+		var a article
+		if err := r.data.db.WithContext(ctx).First(&a, id).Error; err != nil {
+			return nil, err
+		}
+		if err := loadTags(r.data.db.WithContext(ctx), &a); err != nil {
+			return nil, err
+		}
+		if err := loadAuthors(r.data.db.WithContext(ctx), &a); err != nil {
+			return nil, err
+		}
+		return &a, nil
+	})
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.log.Errorf("Get error: %v", err)
+		}
+		return nil, translateErr(err)
+	}
+	return r.toDomain(a), nil
+}
+
+func (r *articleRepo) GetArticleAuthor(ctx context.Context, id int64) (int64, error) {
+	var ids []int64
+	err := r.data.db.WithContext(ctx).Unscoped().Model(&article{}).
+		Where("id = ?", id).Limit(1).Pluck("author_id", &ids).Error
+	if err != nil {
+		r.log.Errorf("GetArticleAuthor error: %v", err)
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, biz.ErrArticleNotFound
 	}
-	return r.toDomain(&a), nil
+	return ids[0], nil
 }
 
-func (r *articleRepo) CreateArticle(ctx context.Context, a *biz.Article) error {
+func (r *articleRepo) CreateArticle(ctx context.Context, a *biz.Article, author string) error {
 	model := r.toModel(a)
-	err := r.data.db.WithContext(ctx).Create(&model).Error
+	model.Version = 1
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkCategory(tx, a.CategoryId); err != nil {
+			return err
+		}
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+		if err := setArticleTags(tx, model.Id, a.Tags); err != nil {
+			return err
+		}
+		return addRevision(tx, model, author)
+	})
 	if err != nil {
 		r.log.Errorf("Create error: %v", err)
-		return err
+		return translateErr(err)
 	}
 	a.Id = model.Id
+	a.CreatedAt = model.CreatedAt
+	a.UpdatedAt = model.UpdatedAt
+	a.Version = model.Version
+	r.cache.del(ctx, a.Id) // 清除可能存在的负缓存
 	return nil
 }
 
-func (r *articleRepo) UpdateArticle(ctx context.Context, id int64, a *biz.Article) error {
-	m := r.toModel(a)
-	m.Id = id // 确保更新目标ID正确
-	err := r.data.db.WithContext(ctx).Updates(&m).Error
+func (r *articleRepo) UpdateArticle(ctx context.Context, id int64, a *biz.Article, fields []string, author string) error {
+	values := map[string]interface{}{
+		"version":    gorm.Expr("version + 1"),
+		"updated_at": time.Now(),
+	}
+	var tags bool
+	for _, f := range fields {
+		switch f {
+		case biz.ArticleFieldTitle:
+			values["title"] = a.Title
+		case biz.ArticleFieldContent:
+			values["content"] = a.Content
+		case biz.ArticleFieldTags:
+			tags = true
+		case biz.ArticleFieldCategory:
+			values["category_id"] = int64Ptr(a.CategoryId)
+		default:
+			return fmt.Errorf("unknown article field %q", f)
+		}
+	}
+
+	defer r.cache.del(ctx, id)
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, ok := values["category_id"]; ok {
+			if err := checkCategory(tx, a.CategoryId); err != nil {
+				return err
+			}
+		}
+		db := tx.Model(&article{}).Where("id = ?", id)
+		if a.Version > 0 {
+			db = db.Where("version = ?", a.Version) // 乐观锁
+		}
+		result := db.Updates(values)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// version 每次都会变化，影响行数为 0 说明记录不存在或版本已过期
+			var n int64
+			if err := tx.Model(&article{}).Where("id = ?", id).Count(&n).Error; err != nil {
+				return translateErr(err)
+			}
+			if n == 0 {
+				return biz.ErrArticleNotFound
+			}
+			return biz.ErrArticleConflict
+		}
+		if tags {
+			if err := setArticleTags(tx, id, a.Tags); err != nil {
+				return err
+			}
+		}
+		var model article
+		if err := tx.First(&model, id).Error; err != nil {
+			return err
+		}
+		return addRevision(tx, &model, author)
+	})
 	if err != nil {
-		r.log.Errorf("Update error: %v", err)
-		return err
+		if _, ok := err.(*errors.Error); !ok {
+			r.log.Errorf("Update error: %v", err)
+		}
+		return translateErr(err)
 	}
 	return nil
 }
 
 func (r *articleRepo) DeleteArticle(ctx context.Context, id int64) error {
-	return r.data.db.WithContext(ctx).Delete(&article{}, id).Error
+	result := r.data.db.WithContext(ctx).Delete(&article{}, id)
+	if result.Error != nil {
+		r.log.Errorf("Delete error: %v", result.Error)
+		return translateErr(result.Error)
+	}
+	r.cache.del(ctx, id)
+	if result.RowsAffected == 0 {
+		return biz.ErrArticleNotFound
+	}
+	return nil
+}
+
+func (r *articleRepo) RestoreArticle(ctx context.Context, id int64, author string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&article{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Updates(map[string]interface{}{
+				"deleted_at": nil,
+				"version":    gorm.Expr("version + 1"),
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrArticleNotFound
+		}
+		// 恢复也是一个新版本，同样记一个 revision
+		var model article
+		if err := tx.First(&model, id).Error; err != nil {
+			return err
+		}
+		return addRevision(tx, &model, author)
+	})
+	r.cache.del(ctx, id) // 清除删除期间写入的负缓存
+	if err != nil {
+		if !biz.ErrArticleNotFound.Is(err) {
+			r.log.Errorf("Restore error: %v", err)
+		}
+		return translateErr(err)
+	}
+	return nil
+}
+
+func (r *articleRepo) PurgeArticle(ctx context.Context, id int64, trashedBefore time.Time) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		db := tx.Unscoped()
+		if !trashedBefore.IsZero() {
+			// 条件放在 DELETE 上，列出后被恢复的文章不会被删除
+			db = db.Where("deleted_at IS NOT NULL AND deleted_at < ?", trashedBefore)
+		}
+		result := db.Delete(&article{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrArticleNotFound
+		}
+		if err := tx.Where("article_id = ?", id).Delete(&articleTag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("article_id = ?", id).Delete(&comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("article_id = ?", id).Delete(&articleLike{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("article_id = ?", id).Delete(&articleRevision{}).Error
+	})
+	if err != nil {
+		if !biz.ErrArticleNotFound.Is(err) {
+			r.log.Errorf("Purge error: %v", err)
+		}
+		return translateErr(err)
+	}
+	r.removeArticleState(ctx, id)
+	return nil
+}
+
+func (r *articleRepo) ListDeletedBefore(ctx context.Context, t time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := r.data.db.WithContext(ctx).Unscoped().Model(&article{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", t).
+		Order("deleted_at").Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		r.log.Errorf("ListDeletedBefore error: %v", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *articleRepo) TransitArticle(ctx context.Context, id int64, t *biz.ArticleTransition) error {
+	from := make([]string, 0, len(t.From))
+	for _, s := range t.From {
+		from = append(from, string(s))
+	}
+	// publish_at 只在 scheduled 时有值，published_at 只在 published 时有值
+	values := map[string]interface{}{
+		"status":       string(t.To),
+		"publish_at":   nil,
+		"published_at": nil,
+	}
+	switch t.To {
+	case biz.ArticleStatusScheduled:
+		values["publish_at"] = t.PublishAt
+	case biz.ArticleStatusPublished:
+		values["published_at"] = t.At
+	}
+
+	// 状态变化同样产生新版本，旧的 ETag 随之失效
+	values["version"] = gorm.Expr("version + 1")
+	values["updated_at"] = t.At
+
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		db := tx.Model(&article{}).Where("id = ? AND status IN ?", id, from)
+		if t.Due {
+			db = db.Where("publish_at <= ?", t.At)
+		}
+		result := db.Updates(values)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var n int64
+			if err := tx.Model(&article{}).Where("id = ?", id).Count(&n).Error; err != nil {
+				return err
+			}
+			if n == 0 {
+				return biz.ErrArticleNotFound
+			}
+			return biz.ErrInvalidStatusTransition
+		}
+		var model article
+		if err := tx.First(&model, id).Error; err != nil {
+			return err
+		}
+		return addRevision(tx, &model, t.Author)
+	})
+	r.cache.del(ctx, id)
+	if err != nil {
+		if _, ok := err.(*errors.Error); !ok {
+			r.log.Errorf("Transit error: %v", err)
+		}
+		return translateErr(err)
+	}
+	return nil
+}
+
+func (r *articleRepo) ListDueArticles(ctx context.Context, t time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := r.data.db.WithContext(ctx).Model(&article{}).
+		Where("status = ? AND publish_at <= ?", string(biz.ArticleStatusScheduled), t).
+		Order("publish_at").Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		r.log.Errorf("ListDueArticles error: %v", err)
+		return nil, err
+	}
+	return ids, nil
 }
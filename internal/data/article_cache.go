@@ -0,0 +1,159 @@
+package data
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultArticleCacheTTL  = 10 * time.Minute
+	defaultNegativeCacheTTL = 30 * time.Second
+	// articleLoadTimeout 合并回源的超时，回源与发起请求的 ctx 解绑，同时也是回源租约的有效期
+	articleLoadTimeout = 3 * time.Second
+)
+
+// missingArticle 负缓存占位值，表示该 id 在数据库中不存在
+const missingArticle = "-"
+
+// articleCacheKey 缓存的是 article 模型的 JSON，模型字段变化时升级前缀里的版本号，避免读到旧结构
+func articleCacheKey(id int64) string {
+	return fmt.Sprintf("article:v6:%d", id)
+}
+
+// articleLeaseKey 回源租约，写操作删除缓存时一并删除，使进行中的回源放弃写入
+func articleLeaseKey(id int64) string {
+	return fmt.Sprintf("article:lease:%d", id)
+}
+
+// setIfLeasedScript 租约仍属于本次回源时才写入缓存并释放租约，返回是否写入
+var setIfLeasedScript = redis.NewScript(`
+if redis.call('GET', KEYS[2]) == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+	redis.call('DEL', KEYS[2])
+	return 1
+end
+return 0
+`)
+
+// articleCache 文章详情的 cache-aside 缓存，redis 不可用时只记录日志，读写降级到数据库
+type articleCache struct {
+	rdb         *redis.Client
+	ttl         time.Duration
+	jitter      time.Duration
+	negativeTTL time.Duration
+	group       singleflight.Group
+	log         *log.Helper
+}
+
+func newArticleCache(c *conf.Data, rdb *redis.Client, logger log.Logger) *articleCache {
+	ac := &articleCache{
+		rdb:         rdb,
+		ttl:         defaultArticleCacheTTL,
+		negativeTTL: defaultNegativeCacheTTL,
+		log:         log.NewHelper(logger),
+	}
+	if r := c.GetRedis(); r != nil {
+		if r.CacheTtl != nil && r.CacheTtl.AsDuration() > 0 {
+			ac.ttl = r.CacheTtl.AsDuration()
+		}
+		if r.CacheTtlJitter != nil {
+			ac.jitter = r.CacheTtlJitter.AsDuration()
+		}
+		if r.NegativeCacheTtl != nil && r.NegativeCacheTtl.AsDuration() > 0 {
+			ac.negativeTTL = r.NegativeCacheTtl.AsDuration()
+		}
+	}
+	return ac
+}
+
+// expiration 在 ttl 上叠加随机抖动，避免同一批 key 同时过期
+func (c *articleCache) expiration() time.Duration {
+	if c.jitter <= 0 {
+		return c.ttl
+	}
+	return c.ttl + time.Duration(rand.Int63n(int64(c.jitter)))
+}
+
+// get 命中时返回文章；命中负缓存时返回 (nil, true)；未命中或出错时 ok 为 false
+func (c *articleCache) get(ctx context.Context, id int64) (a *article, ok bool) {
+	val, err := c.rdb.Get(ctx, articleCacheKey(id)).Result()
+	if err != nil {
+		if err != redis.Nil {
+			c.log.Warnf("article cache get error: %v", err)
+		}
+		return nil, false
+	}
+	if val == missingArticle {
+		return nil, true
+	}
+	a = new(article)
+	if err = json.Unmarshal([]byte(val), a); err != nil {
+		c.log.Warnf("article cache decode error: %v", err)
+		return nil, false
+	}
+	return a, true
+}
+
+// lease 在回源前取得租约，返回的 token 用于 fill；取租约失败时返回空串，本次回源不写缓存
+func (c *articleCache) lease(ctx context.Context, id int64) string {
+	token := strconv.FormatInt(rand.Int63(), 36)
+	if err := c.rdb.Set(ctx, articleLeaseKey(id), token, articleLoadTimeout).Err(); err != nil {
+		c.log.Warnf("article cache lease error: %v", err)
+		return ""
+	}
+	return token
+}
+
+// fill 以租约 token 写入 val，回源期间缓存被删除过则放弃
+func (c *articleCache) fill(ctx context.Context, id int64, token, val string, ttl time.Duration) {
+	if token == "" {
+		return
+	}
+	keys := []string{articleCacheKey(id), articleLeaseKey(id)}
+	if err := setIfLeasedScript.Run(ctx, c.rdb, keys, token, val, ttl.Milliseconds()).Err(); err != nil {
+		c.log.Warnf("article cache set error: %v", err)
+	}
+}
+
+func (c *articleCache) del(ctx context.Context, id int64) {
+	if err := c.rdb.Del(ctx, articleCacheKey(id), articleLeaseKey(id)).Err(); err != nil {
+		c.log.Warnf("article cache del error: %v", err)
+	}
+}
+
+// load 合并同一 id 的并发回源请求，避免热点 key 失效时击穿数据库。
+// 回源使用与调用方解绑的 ctx，首个调用方取消不会让其他等待者一起失败；
+// fn 返回 gorm.ErrRecordNotFound 时写入负缓存
+func (c *articleCache) load(ctx context.Context, id int64, fn func(ctx context.Context) (*article, error)) (*article, error) {
+	v, err, _ := c.group.Do(strconv.FormatInt(id, 10), func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), articleLoadTimeout)
+		defer cancel()
+		token := c.lease(ctx, id)
+		a, err := fn(ctx)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.fill(ctx, id, token, missingArticle, c.negativeTTL)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if bytes, err := json.Marshal(a); err == nil {
+			c.fill(ctx, id, token, string(bytes), c.expiration())
+		}
+		return a, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*article), nil
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+func TestArticleCacheHitAndInvalidation(t *testing.T) {
+	d, c, mr := newTestData(t)
+	repo := NewArticleRepo(c, d, log.DefaultLogger)
+	ctx := authorContext(1)
+	a := &biz.Article{Title: "cached", Content: "v1", AuthorId: 1, Status: biz.ArticleStatusDraft}
+	if err := repo.CreateArticle(ctx, a, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(articleCacheKey(a.Id)) {
+		t.Fatal("cached before first read")
+	}
+	if _, err := repo.GetArticle(ctx, a.Id); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists(articleCacheKey(a.Id)) {
+		t.Fatal("miss did not fill the cache")
+	}
+	if mr.Exists(articleLeaseKey(a.Id)) {
+		t.Fatal("lease not released")
+	}
+
+	// 绕过 repo 改库，命中缓存时仍读到旧值
+	if err := d.db.Model(&article{}).Where("id = ?", a.Id).Update("content", "v2").Error; err != nil {
+		t.Fatal(err)
+	}
+	got, err := repo.GetArticle(ctx, a.Id)
+	if err != nil || got.Content != "v1" {
+		t.Fatalf("hit = %+v %v, want cached v1", got, err)
+	}
+
+	a.Content = "v3"
+	if err = repo.UpdateArticle(ctx, a.Id, a, []string{biz.ArticleFieldContent}, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(articleCacheKey(a.Id)) {
+		t.Fatal("update did not invalidate the cache")
+	}
+	if got, err = repo.GetArticle(ctx, a.Id); err != nil || got.Content != "v3" {
+		t.Fatalf("after update = %+v %v", got, err)
+	}
+}
+
+func TestArticleCacheNegative(t *testing.T) {
+	d, c, mr := newTestData(t)
+	repo := NewArticleRepo(c, d, log.DefaultLogger)
+	ctx := authorContext(1)
+
+	if _, err := repo.GetArticle(ctx, 1); !biz.ErrArticleNotFound.Is(err) {
+		t.Fatalf("err = %v", err)
+	}
+	if v, _ := mr.Get(articleCacheKey(1)); v != missingArticle {
+		t.Fatalf("negative cache = %q", v)
+	}
+	if ttl := mr.TTL(articleCacheKey(1)); ttl <= 0 || ttl > defaultNegativeCacheTTL {
+		t.Fatalf("negative ttl = %v", ttl)
+	}
+	// 创建后负缓存失效
+	a := &biz.Article{Title: "later", Content: "x", AuthorId: 1, Status: biz.ArticleStatusDraft}
+	if err := repo.CreateArticle(ctx, a, "u1"); err != nil || a.Id != 1 {
+		t.Fatalf("create = %d %v", a.Id, err)
+	}
+	if _, err := repo.GetArticle(ctx, 1); err != nil {
+		t.Fatalf("after create err = %v", err)
+	}
+}
+
+func TestArticleCacheStaleFill(t *testing.T) {
+	d, c, mr := newTestData(t)
+	cache := newArticleCache(c, d.rdb, log.DefaultLogger)
+	ctx := context.Background()
+
+	// 回源期间发生写操作删除了缓存，回源结果不能写入
+	_, err := cache.load(ctx, 1, func(ctx context.Context) (*article, error) {
+		cache.del(ctx, 1)
+		return &article{Id: 1, Title: "stale"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(articleCacheKey(1)) {
+		t.Fatal("stale load filled the cache")
+	}
+
+	if _, err = cache.load(ctx, 1, func(ctx context.Context) (*article, error) {
+		return nil, gorm.ErrRecordNotFound
+	}); err != gorm.ErrRecordNotFound {
+		t.Fatalf("err = %v", err)
+	}
+	if v, _ := mr.Get(articleCacheKey(1)); v != missingArticle {
+		t.Fatalf("negative cache = %q", v)
+	}
+}
+
+func TestArticleCacheLoadDetached(t *testing.T) {
+	d, c, _ := newTestData(t)
+	cache := newArticleCache(c, d.rdb, log.DefaultLogger)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	a, err := cache.load(ctx, 1, func(ctx context.Context) (*article, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return &article{Id: 1}, nil
+	})
+	if err != nil || a.Id != 1 {
+		t.Fatalf("load with canceled caller = %+v %v", a, err)
+	}
+	if got, ok := cache.get(context.Background(), 1); !ok || got.Id != 1 {
+		t.Fatalf("cache get = %+v %v", got, ok)
+	}
+}
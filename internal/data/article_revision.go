@@ -0,0 +1,80 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+)
+
+// articleRevision 文章每次写入后的快照，只追加不修改
+type articleRevision struct {
+	Id        int64     `gorm:"primaryKey"`
+	ArticleId int64     `gorm:"column:article_id"`
+	Version   int64     `gorm:"column:version"`
+	Title     string    `gorm:"size:100"`
+	Content   string    `gorm:"type:text"`
+	Author    string    `gorm:"size:64"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (articleRevision) TableName() string {
+	return "article_revision"
+}
+
+func (r *articleRepo) toRevisionDomain(rev *articleRevision) *biz.ArticleRevision {
+	return &biz.ArticleRevision{
+		ArticleId: rev.ArticleId,
+		Version:   rev.Version,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Author:    rev.Author,
+		CreatedAt: rev.CreatedAt,
+	}
+}
+
+// addRevision 在同一事务内把文章当前状态记为一个 revision
+func addRevision(tx *gorm.DB, a *article, author string) error {
+	return tx.Create(&articleRevision{
+		ArticleId: a.Id,
+		Version:   a.Version,
+		Title:     a.Title,
+		Content:   a.Content,
+		Author:    author,
+		CreatedAt: a.UpdatedAt,
+	}).Error
+}
+
+func (r *articleRepo) ListArticleRevisions(ctx context.Context, id int64, before int64, limit int) ([]*biz.ArticleRevision, error) {
+	db := r.data.db.WithContext(ctx).
+		Select("article_id", "version", "title", "author", "created_at").
+		Where("article_id = ?", id)
+	if before > 0 {
+		db = db.Where("version < ?", before)
+	}
+	var list []*articleRevision
+	if err := db.Order("version DESC").Limit(limit).Find(&list).Error; err != nil {
+		r.log.Errorf("ListRevisions error: %v", err)
+		return nil, err
+	}
+	result := make([]*biz.ArticleRevision, 0, len(list))
+	for _, item := range list {
+		result = append(result, r.toRevisionDomain(item))
+	}
+	return result, nil
+}
+
+func (r *articleRepo) GetArticleRevision(ctx context.Context, id int64, version int64) (*biz.ArticleRevision, error) {
+	var rev articleRevision
+	err := r.data.db.WithContext(ctx).Where("article_id = ? AND version = ?", id, version).First(&rev).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrRevisionNotFound
+		}
+		r.log.Errorf("GetRevision error: %v", err)
+		return nil, err
+	}
+	return r.toRevisionDomain(&rev), nil
+}
@@ -0,0 +1,61 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"testing"
+)
+
+func TestArticleRevisionAuthorIsCaller(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	a := &biz.Article{Title: "title", Content: "v1"}
+	if err := uc.Create(authorContext(1), a); err != nil {
+		t.Fatal(err)
+	}
+	a.Content = "v2"
+	if _, err := uc.Update(authorContext(2), a.Id, a, []string{biz.ArticleFieldContent}); err != nil {
+		t.Fatal(err)
+	}
+	rb, err := uc.Rollback(authorContext(3), a.Id, 1, 0)
+	if err != nil || rb.Content != "v1" || rb.Version != 3 {
+		t.Fatalf("Rollback = %+v %v", rb, err)
+	}
+	for version, want := range map[int64]string{1: "u1", 2: "u2", 3: "u3"} {
+		rev, err := uc.GetRevision(authorContext(1), a.Id, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rev.Author != want {
+			t.Errorf("revision %d author = %q, want %q", version, rev.Author, want)
+		}
+	}
+}
+
+func TestArticleRestoreWritesRevision(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ctx := authorContext(1)
+	a := &biz.Article{Title: "title", Content: "content"}
+	if err := uc.Create(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.Delete(ctx, a.Id, false); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := uc.Restore(authorContext(2), a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Version != 2 {
+		t.Fatalf("restored version = %d, want 2", restored.Version)
+	}
+	rev, err := uc.GetRevision(ctx, a.Id, restored.Version)
+	if err != nil {
+		t.Fatalf("no revision for the restored version: %v", err)
+	}
+	if rev.Author != "u2" || rev.Content != "content" {
+		t.Fatalf("revision = %+v", rev)
+	}
+	page, err := uc.ListRevisions(ctx, a.Id, 10, "")
+	if err != nil || len(page.Revisions) != 2 {
+		t.Fatalf("ListRevisions = %+v %v", page, err)
+	}
+}
@@ -0,0 +1,371 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestArticleUsecase(t *testing.T) (*biz.ArticleUsecase, *miniredis.Miniredis) {
+	t.Helper()
+	d, c, mr := newTestData(t)
+	searcher, err := NewArticleSearcher(c, d, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc := biz.NewArticleUsecase(testAuth(), NewArticleRepo(c, d, log.DefaultLogger), NewLocker(d, log.DefaultLogger), searcher, log.DefaultLogger)
+	return uc, mr
+}
+
+// authorContext 返回以 userId 身份发起请求的 ctx
+func authorContext(userId int64) context.Context {
+	return biz.NewCallerContext(context.Background(), &biz.Caller{UserId: userId, Username: fmt.Sprint("u", userId), Role: "author"})
+}
+
+// createPublished 创建并发布 n 篇文章，返回它们的 id
+func createPublished(t *testing.T, uc *biz.ArticleUsecase, n int) []int64 {
+	t.Helper()
+	ctx := authorContext(1)
+	ids := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		a := &biz.Article{Title: fmt.Sprintf("title %d", i), Content: "content"}
+		if err := uc.Create(ctx, a); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := uc.Publish(ctx, a.Id); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, a.Id)
+	}
+	return ids
+}
+
+func TestListArticlePagination(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ids := createPublished(t, uc, 5)
+	ctx := context.Background()
+
+	var got []int64
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("pagination does not terminate")
+		}
+		page, err := uc.List(ctx, &biz.ListArticleQuery{PageSize: 2, PageToken: token, OrderBy: "created_at asc", WithTotal: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != 5 {
+			t.Fatalf("total = %d, want 5", page.Total)
+		}
+		for _, a := range page.Articles {
+			got = append(got, a.Id)
+		}
+		if token = page.NextPageToken; token == "" {
+			break
+		}
+	}
+	if fmt.Sprint(got) != fmt.Sprint(ids) {
+		t.Fatalf("paged ids = %v, want %v", got, ids)
+	}
+}
+
+func TestListArticleTokenPinsOrder(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	createPublished(t, uc, 3)
+	ctx := context.Background()
+
+	page, err := uc.List(ctx, &biz.ListArticleQuery{PageSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.NextPageToken == "" {
+		t.Fatal("expected a next page")
+	}
+	_, err = uc.List(ctx, &biz.ListArticleQuery{PageSize: 1, PageToken: page.NextPageToken, OrderBy: "like_count"})
+	if !biz.ErrInvalidPageToken.Is(err) {
+		t.Fatalf("err = %v, want ErrInvalidPageToken", err)
+	}
+	if _, err = uc.List(ctx, &biz.ListArticleQuery{OrderBy: "title"}); !biz.ErrInvalidOrderBy.Is(err) {
+		t.Fatalf("err = %v, want ErrInvalidOrderBy", err)
+	}
+}
+
+func TestListArticleOrderByLikes(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ids := createPublished(t, uc, 3)
+	ctx := context.Background()
+
+	// 第二篇两个赞，第三篇一个赞
+	for _, like := range []struct {
+		id   int64
+		user int64
+	}{{ids[1], 2}, {ids[1], 3}, {ids[2], 2}} {
+		if _, err := uc.Like(authorContext(like.user), like.id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := uc.FlushLikes(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	page, err := uc.List(ctx, &biz.ListArticleQuery{OrderBy: "like_count desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int64
+	for _, a := range page.Articles {
+		got = append(got, a.Id)
+	}
+	want := []int64{ids[1], ids[2], ids[0]}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("ids by likes = %v, want %v", got, want)
+	}
+}
+
+func TestListArticleFilter(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ids := createPublished(t, uc, 3)
+	ctx := context.Background()
+
+	page, err := uc.List(ctx, &biz.ListArticleQuery{ArticleFilter: biz.ArticleFilter{Title: "title 1"}, WithTotal: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 1 || len(page.Articles) != 1 || page.Articles[0].Id != ids[1] {
+		t.Fatalf("title filter = %+v", page)
+	}
+	page, err = uc.List(ctx, &biz.ListArticleQuery{ArticleFilter: biz.ArticleFilter{AuthorId: 2}, WithTotal: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 0 || len(page.Articles) != 0 {
+		t.Fatalf("author filter = %+v", page)
+	}
+}
+
+func TestArticleNotFound(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ctx := authorContext(1)
+	if _, err := uc.Get(ctx, 99); !biz.ErrArticleNotFound.Is(err) {
+		t.Fatalf("Get err = %v", err)
+	}
+	if _, err := uc.Update(ctx, 99, &biz.Article{Title: "x"}, []string{biz.ArticleFieldTitle}); !biz.ErrArticleNotFound.Is(err) {
+		t.Fatalf("Update err = %v", err)
+	}
+	if err := uc.Delete(ctx, 99, false); !biz.ErrArticleNotFound.Is(err) {
+		t.Fatalf("Delete err = %v", err)
+	}
+}
+
+func TestArticleTitleNotUnique(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ctx := authorContext(1)
+	for i := 0; i < 2; i++ {
+		if err := uc.Create(ctx, &biz.Article{Title: "same", Content: "x"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestArticleLike(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	id := createPublished(t, uc, 1)[0]
+
+	if _, err := uc.Like(context.Background(), id); !biz.ErrUnauthenticated.Is(err) {
+		t.Fatalf("anonymous like err = %v", err)
+	}
+	for i, want := range []int64{1, 1} {
+		if n, err := uc.Like(authorContext(2), id); err != nil || n != want {
+			t.Fatalf("like #%d = %d %v, want %d", i, n, err, want)
+		}
+	}
+	if n, err := uc.Like(authorContext(3), id); err != nil || n != 2 {
+		t.Fatalf("second user like = %d %v", n, err)
+	}
+	if n, err := uc.Unlike(authorContext(2), id); err != nil || n != 1 {
+		t.Fatalf("unlike = %d %v", n, err)
+	}
+	if n, err := uc.Unlike(authorContext(2), id); err != nil || n != 1 {
+		t.Fatalf("repeated unlike = %d %v", n, err)
+	}
+}
+
+func TestArticleViewsPersisted(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	id := createPublished(t, uc, 1)[0]
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		a, err := uc.Get(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Views != int64(i) {
+			t.Fatalf("views = %d, want %d", a.Views, i)
+		}
+	}
+	if n, err := uc.FlushViews(ctx, 10); err != nil || n != 1 {
+		t.Fatalf("FlushViews = %d %v", n, err)
+	}
+}
+
+func TestArticleViewsSurviveRedisRestart(t *testing.T) {
+	uc, mr := newTestArticleUsecase(t)
+	id := createPublished(t, uc, 1)[0]
+	ctx := context.Background()
+
+	uc.Get(ctx, id)
+	uc.Get(ctx, id)
+	if _, err := uc.FlushViews(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	mr.FlushAll() // redis 重启丢失数据
+	a, err := uc.Get(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Views != 3 {
+		t.Fatalf("views after restart = %d, want 3", a.Views)
+	}
+}
+
+func TestArticleGetWithoutRedis(t *testing.T) {
+	uc, mr := newTestArticleUsecase(t)
+	id := createPublished(t, uc, 1)[0]
+
+	mr.Close()
+	if _, err := uc.Get(context.Background(), id); err != nil {
+		t.Fatalf("Get without redis: %v", err)
+	}
+}
+
+func TestCounterStoreErrors(t *testing.T) {
+	d, c, mr := newTestData(t)
+	repo := NewArticleRepo(c, d, log.DefaultLogger)
+	a := &biz.Article{Title: "t", Content: "c", AuthorId: 1, Status: biz.ArticleStatusPublished}
+	if err := repo.CreateArticle(authorContext(1), a, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	mr.Close()
+	// 浏览数故障不能报成点赞存储故障
+	if _, err := repo.IncArticleView(ctx, a.Id); !biz.ErrViewStoreUnavailable.Is(err) {
+		t.Fatalf("IncArticleView err = %v, want view store unavailable", err)
+	}
+	if _, err := repo.FlushArticleViews(ctx, 10); !biz.ErrViewStoreUnavailable.Is(err) {
+		t.Fatalf("FlushArticleViews err = %v, want view store unavailable", err)
+	}
+	if _, err := repo.LikeArticle(ctx, a.Id, 2); !biz.ErrLikeStoreUnavailable.Is(err) {
+		t.Fatalf("LikeArticle err = %v, want like store unavailable", err)
+	}
+}
+
+func TestArticleLikesSurviveRedisRestart(t *testing.T) {
+	uc, mr := newTestArticleUsecase(t)
+	id := createPublished(t, uc, 1)[0]
+	ctx := context.Background()
+
+	uc.Like(authorContext(2), id)
+	uc.Like(authorContext(3), id)
+	uc.Unlike(authorContext(3), id)
+	if _, err := uc.FlushLikes(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	mr.FlushAll() // redis 重启丢失数据
+
+	if n, err := uc.Like(authorContext(2), id); err != nil || n != 1 {
+		t.Fatalf("relike after restart = %d %v, want 1", n, err)
+	}
+	if n, err := uc.Like(authorContext(3), id); err != nil || n != 2 {
+		t.Fatalf("like after restart = %d %v, want 2", n, err)
+	}
+}
+
+func TestPurgeArticleSkipsRestored(t *testing.T) {
+	d, c, _ := newTestData(t)
+	repo := NewArticleRepo(c, d, log.DefaultLogger)
+	ctx := authorContext(1)
+	a := &biz.Article{Title: "t", Content: "c", AuthorId: 1, Status: biz.ArticleStatusDraft}
+	if err := repo.CreateArticle(ctx, a, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.DeleteArticle(ctx, a.Id); err != nil {
+		t.Fatal(err)
+	}
+	cutoff := time.Now().Add(time.Hour)
+	ids, err := repo.ListDeletedBefore(ctx, cutoff, 10)
+	if err != nil || len(ids) != 1 {
+		t.Fatalf("ListDeletedBefore = %v %v", ids, err)
+	}
+	// 列出之后、删除之前被恢复
+	if err = repo.RestoreArticle(ctx, a.Id, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = repo.PurgeArticle(ctx, a.Id, cutoff); !biz.ErrArticleNotFound.Is(err) {
+		t.Fatalf("purge restored = %v", err)
+	}
+	if _, err = repo.GetArticle(ctx, a.Id); err != nil {
+		t.Fatalf("restored article gone: %v", err)
+	}
+	// 不限制删除时间时无论是否在回收站都删除
+	if err = repo.PurgeArticle(ctx, a.Id, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = repo.GetArticleAuthor(ctx, a.Id); !biz.ErrArticleNotFound.Is(err) {
+		t.Fatalf("purged article err = %v", err)
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	d, c, _ := newTestData(t)
+	searcher, err := NewArticleSearcher(c, d, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	locker := NewLocker(d, log.DefaultLogger)
+	uc := biz.NewArticleUsecase(testAuth(), NewArticleRepo(c, d, log.DefaultLogger), locker, searcher, log.DefaultLogger)
+	ids := createPublished(t, uc, 3)
+	ctx := authorContext(1)
+	for _, id := range ids[:2] {
+		if err = uc.Delete(ctx, id, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cutoff := time.Now().Add(time.Hour)
+
+	// 其他副本持有锁时不执行
+	unlock, ok, err := locker.TryLock(ctx, "article:purge", time.Minute)
+	if err != nil || !ok {
+		t.Fatalf("TryLock = %v %v", ok, err)
+	}
+	if n, err := uc.PurgeTrash(ctx, cutoff, 1, time.Minute); n != 0 || err != nil {
+		t.Fatalf("purged while locked = %d %v", n, err)
+	}
+	unlock()
+
+	if n, err := uc.PurgeTrash(ctx, cutoff, 1, time.Minute); n != 2 || err != nil {
+		t.Fatalf("PurgeTrash = %d %v, want 2", n, err)
+	}
+	if _, err = uc.Get(ctx, ids[2]); err != nil {
+		t.Fatalf("live article purged: %v", err)
+	}
+}
+
+func TestCreateArticleRequiresCaller(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	if err := uc.Create(context.Background(), &biz.Article{Title: "t", Content: "c"}); !biz.ErrUnauthenticated.Is(err) {
+		t.Fatalf("anonymous create err = %v", err)
+	}
+	a := &biz.Article{Title: "t", Content: "c", AuthorId: 42}
+	if err := uc.Create(authorContext(7), a); err != nil {
+		t.Fatal(err)
+	}
+	if a.AuthorId != 7 {
+		t.Fatalf("author = %d, want the caller", a.AuthorId)
+	}
+}
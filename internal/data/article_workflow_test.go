@@ -0,0 +1,192 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestArticleTransitions(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ctx := authorContext(1)
+	future := time.Now().Add(time.Hour)
+
+	// 把新建的草稿推进到 from 状态
+	reach := map[biz.ArticleStatus]func(id int64) error{
+		biz.ArticleStatusDraft:     func(int64) error { return nil },
+		biz.ArticleStatusInReview:  func(id int64) error { _, err := uc.Submit(ctx, id); return err },
+		biz.ArticleStatusScheduled: func(id int64) error { _, err := uc.Schedule(ctx, id, future); return err },
+		biz.ArticleStatusPublished: func(id int64) error { _, err := uc.Publish(ctx, id); return err },
+		biz.ArticleStatusArchived:  func(id int64) error { _, err := uc.Archive(ctx, id); return err },
+	}
+	moves := map[biz.ArticleStatus]func(id int64) (*biz.Article, error){
+		biz.ArticleStatusInReview:  func(id int64) (*biz.Article, error) { return uc.Submit(ctx, id) },
+		biz.ArticleStatusScheduled: func(id int64) (*biz.Article, error) { return uc.Schedule(ctx, id, future) },
+		biz.ArticleStatusPublished: func(id int64) (*biz.Article, error) { return uc.Publish(ctx, id) },
+		biz.ArticleStatusDraft:     func(id int64) (*biz.Article, error) { return uc.Unpublish(ctx, id) },
+		biz.ArticleStatusArchived:  func(id int64) (*biz.Article, error) { return uc.Archive(ctx, id) },
+	}
+	const (
+		draft     = biz.ArticleStatusDraft
+		inReview  = biz.ArticleStatusInReview
+		scheduled = biz.ArticleStatusScheduled
+		published = biz.ArticleStatusPublished
+		archived  = biz.ArticleStatusArchived
+	)
+	tests := []struct {
+		from, to biz.ArticleStatus
+		allowed  bool
+	}{
+		{draft, inReview, true},
+		{draft, scheduled, true},
+		{draft, published, true},
+		{draft, archived, true},
+		{draft, draft, false},
+		{inReview, scheduled, true},
+		{inReview, published, true},
+		{inReview, draft, true},
+		{inReview, inReview, false},
+		{scheduled, published, true},
+		{scheduled, draft, true},
+		{scheduled, archived, true},
+		{scheduled, inReview, false},
+		{published, archived, true},
+		{published, draft, true},
+		{published, inReview, false},
+		{published, scheduled, false},
+		{archived, draft, true},
+		{archived, published, false},
+		{archived, inReview, false},
+		{archived, scheduled, false},
+	}
+	for i, tt := range tests {
+		a := &biz.Article{Title: "transition", Content: "content"}
+		if err := uc.Create(ctx, a); err != nil {
+			t.Fatal(err)
+		}
+		if err := reach[tt.from](a.Id); err != nil {
+			t.Fatalf("#%d reach %s: %v", i, tt.from, err)
+		}
+		moved, err := moves[tt.to](a.Id)
+		if !tt.allowed {
+			if !biz.ErrInvalidStatusTransition.Is(err) {
+				t.Errorf("%s -> %s: err = %v, want ErrInvalidStatusTransition", tt.from, tt.to, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s -> %s: %v", tt.from, tt.to, err)
+			continue
+		}
+		if moved.Status != tt.to {
+			t.Errorf("%s -> %s: status %s", tt.from, tt.to, moved.Status)
+		}
+	}
+}
+
+func TestArticleTransitionBumpsVersion(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ctx := authorContext(1)
+	a := &biz.Article{Title: "title", Content: "content"}
+	if err := uc.Create(ctx, a); err != nil {
+		t.Fatal(err)
+	}
+	published, err := uc.Publish(authorContext(2), a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if published.Version != a.Version+1 {
+		t.Fatalf("version after publish = %d, want %d", published.Version, a.Version+1)
+	}
+	rev, err := uc.GetRevision(ctx, a.Id, published.Version)
+	if err != nil || rev.Author != "u2" {
+		t.Fatalf("revision of the publish = %+v %v", rev, err)
+	}
+	// 基于发布前版本的更新被拒绝
+	stale := &biz.Article{Content: "changed", Version: a.Version}
+	if _, err = uc.Update(ctx, a.Id, stale, []string{biz.ArticleFieldContent}); !biz.ErrArticleConflict.Is(err) {
+		t.Fatalf("stale update err = %v", err)
+	}
+}
+
+func TestGetUnpublished(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	a := &biz.Article{Title: "draft", Content: "content", Status: biz.ArticleStatusDraft}
+	if err := uc.Create(authorContext(2), a); err != nil {
+		t.Fatal(err)
+	}
+	editor := biz.NewCallerContext(context.Background(), &biz.Caller{UserId: 9, Username: "u9", Role: "editor"})
+	tests := []struct {
+		name string
+		ctx  context.Context
+		ok   bool
+	}{
+		{"author", authorContext(2), true},
+		{"reviewer", editor, true},
+		{"other author", authorContext(3), false},
+		{"anonymous", context.Background(), false},
+	}
+	for _, tt := range tests {
+		got, err := uc.Get(tt.ctx, a.Id)
+		switch {
+		case tt.ok && (err != nil || got.Status != biz.ArticleStatusDraft):
+			t.Errorf("%s: Get = %+v %v, want the draft", tt.name, got, err)
+		case !tt.ok && !biz.ErrArticleNotFound.Is(err):
+			t.Errorf("%s: Get err = %v, want not found", tt.name, err)
+		}
+	}
+
+	// 读草稿不计入阅读数
+	if _, err := uc.Publish(authorContext(2), a.Id); err != nil {
+		t.Fatal(err)
+	}
+	got, err := uc.Get(context.Background(), a.Id)
+	if err != nil || got.Views != 1 {
+		t.Fatalf("first published read = %+v %v, want 1 view", got, err)
+	}
+}
+
+func TestListTrashByOwner(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	trashed := make(map[int64]int64) // 作者 -> 删除的文章
+	for _, author := range []int64{2, 3} {
+		a := &biz.Article{Title: "title", Content: "content"}
+		if err := uc.Create(authorContext(author), a); err != nil {
+			t.Fatal(err)
+		}
+		if err := uc.Delete(authorContext(author), a.Id, false); err != nil {
+			t.Fatal(err)
+		}
+		trashed[author] = a.Id
+	}
+	editor := biz.NewCallerContext(context.Background(), &biz.Caller{UserId: 9, Username: "u9", Role: "editor"})
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		authorId int64
+		want     []int64
+	}{
+		{"author", authorContext(2), 0, []int64{trashed[2]}},
+		// 指定别人的 id 也只能看到自己的
+		{"author asking for another", authorContext(2), 3, []int64{trashed[2]}},
+		{"reviewer", editor, 0, []int64{trashed[3], trashed[2]}},
+		{"reviewer asking for one", editor, 3, []int64{trashed[3]}},
+	}
+	for _, tt := range tests {
+		page, err := uc.List(tt.ctx, &biz.ListArticleQuery{ArticleFilter: biz.ArticleFilter{Deleted: true, AuthorId: tt.authorId}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int64
+		for _, a := range page.Articles {
+			got = append(got, a.Id)
+		}
+		if !equalIds(got, tt.want) {
+			t.Errorf("%s: trash = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := uc.List(context.Background(), &biz.ListArticleQuery{ArticleFilter: biz.ArticleFilter{Deleted: true}}); !biz.ErrUnauthenticated.Is(err) {
+		t.Fatalf("anonymous trash err = %v, want unauthenticated", err)
+	}
+}
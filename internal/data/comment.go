@@ -0,0 +1,262 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// comment 评论按物化路径存储：path 为祖先 id 链，如 /1/5/，便于整棵子树查询和删除
+type comment struct {
+	Id        int64     `gorm:"primaryKey"`
+	ArticleId int64     `gorm:"column:article_id"`
+	ParentId  int64     `gorm:"column:parent_id"`
+	RootId    int64     `gorm:"column:root_id"` // 所属顶层评论，顶层评论自身为 0
+	Depth     int       `gorm:"column:depth"`
+	Path      string    `gorm:"size:255"`
+	Author    string    `gorm:"size:64"`
+	Content   string    `gorm:"size:1000"`
+	Status    string    `gorm:"size:16"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (comment) TableName() string {
+	return "comment"
+}
+
+// subtree 匹配该评论下的所有回复
+func (c *comment) subtree() string {
+	return c.Path + strconv.FormatInt(c.Id, 10) + "/%"
+}
+
+func (c *comment) toDomain() *biz.Comment {
+	return &biz.Comment{
+		Id:        c.Id,
+		ArticleId: c.ArticleId,
+		ParentId:  c.ParentId,
+		RootId:    c.RootId,
+		Depth:     c.Depth,
+		Author:    c.Author,
+		Content:   c.Content,
+		Status:    biz.CommentStatus(c.Status),
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func toComments(list []*comment) []*biz.Comment {
+	res := make([]*biz.Comment, 0, len(list))
+	for _, c := range list {
+		res = append(res, c.toDomain())
+	}
+	return res
+}
+
+type commentRepo struct {
+	data  *Data
+	cache *articleCache
+	log   *log.Helper
+}
+
+func NewCommentRepo(c *conf.Data, data *Data, logger log.Logger) biz.CommentRepo {
+	return &commentRepo{
+		data:  data,
+		cache: data.articles,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// addCommentCount 在事务内调整文章的评论数，只统计已通过审核的评论
+func addCommentCount(tx *gorm.DB, articleId int64, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	return tx.Unscoped().Model(&article{}).Where("id = ?", articleId).
+		UpdateColumn("comment_count", gorm.Expr("comment_count + ?", delta)).Error
+}
+
+func (r *commentRepo) get(db *gorm.DB, id int64) (*comment, error) {
+	var model comment
+	if err := db.First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return &model, nil
+}
+
+func (r *commentRepo) CreateComment(ctx context.Context, c *biz.Comment) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		model := &comment{
+			ArticleId: c.ArticleId,
+			Depth:     0,
+			Path:      "/",
+			Author:    c.Author,
+			Content:   c.Content,
+			Status:    string(c.Status),
+		}
+		if c.ParentId > 0 {
+			parent, err := r.get(tx, c.ParentId)
+			if err != nil {
+				return err
+			}
+			model.ParentId = parent.Id
+			model.RootId = parent.RootId
+			if parent.RootId == 0 {
+				model.RootId = parent.Id
+			}
+			model.Depth = parent.Depth + 1
+			model.Path = parent.Path + strconv.FormatInt(parent.Id, 10) + "/"
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		if c.Status == biz.CommentStatusApproved {
+			if err := addCommentCount(tx, c.ArticleId, 1); err != nil {
+				return err
+			}
+		}
+		c.Id = model.Id
+		c.Depth = model.Depth
+		c.CreatedAt = model.CreatedAt
+		return nil
+	})
+	if err != nil {
+		if !biz.ErrCommentNotFound.Is(err) {
+			r.log.Errorf("CreateComment error: %v", err)
+		}
+		return err
+	}
+	if c.Status == biz.CommentStatusApproved {
+		r.cache.del(ctx, c.ArticleId)
+	}
+	return nil
+}
+
+func (r *commentRepo) GetComment(ctx context.Context, id int64) (*biz.Comment, error) {
+	model, err := r.get(r.data.db.WithContext(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+	return model.toDomain(), nil
+}
+
+func (r *commentRepo) ListRootComments(ctx context.Context, articleId int64, before int64, limit int) ([]*biz.Comment, error) {
+	db := r.data.db.WithContext(ctx).
+		Where("article_id = ? AND parent_id = 0 AND status = ?", articleId, biz.CommentStatusApproved)
+	if before > 0 {
+		db = db.Where("id < ?", before)
+	}
+	var list []*comment
+	if err := db.Order("id DESC").Limit(limit).Find(&list).Error; err != nil {
+		r.log.Errorf("ListRootComments error: %v", err)
+		return nil, err
+	}
+	return toComments(list), nil
+}
+
+func (r *commentRepo) ListReplies(ctx context.Context, rootIds []int64, limit int) ([]*biz.Comment, error) {
+	if len(rootIds) == 0 {
+		return nil, nil
+	}
+	// 每个顶层评论只取最早的 limit 条：同一组里比它早的回复不足 limit 条。
+	// 不用窗口函数，mysql 5.7 也能执行，子查询走 idx_comment_root_id
+	var list []*comment
+	err := r.data.db.WithContext(ctx).
+		Where("root_id IN ? AND status = ?", rootIds, biz.CommentStatusApproved).
+		Where("(SELECT COUNT(*) FROM comment AS earlier WHERE earlier.root_id = comment.root_id"+
+			" AND earlier.status = comment.status AND earlier.id < comment.id) < ?", limit).
+		Order("id").Find(&list).Error
+	if err != nil {
+		r.log.Errorf("ListReplies error: %v", err)
+		return nil, err
+	}
+	return toComments(list), nil
+}
+
+func (r *commentRepo) ListPendingComments(ctx context.Context, after int64, limit int) ([]*biz.Comment, error) {
+	db := r.data.db.WithContext(ctx).Where("status = ?", biz.CommentStatusPending)
+	if after > 0 {
+		db = db.Where("id > ?", after)
+	}
+	var list []*comment
+	if err := db.Order("id").Limit(limit).Find(&list).Error; err != nil {
+		r.log.Errorf("ListPendingComments error: %v", err)
+		return nil, err
+	}
+	return toComments(list), nil
+}
+
+func (r *commentRepo) ModerateComment(ctx context.Context, id int64, from, to biz.CommentStatus) error {
+	var articleId int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		model, err := r.get(tx, id)
+		if err != nil {
+			return err
+		}
+		articleId = model.ArticleId
+		// 条件更新，状态已被并发修改时不重复计数
+		result := tx.Model(&comment{}).
+			Where("id = ? AND status = ?", id, from).
+			Updates(map[string]interface{}{"status": string(to), "updated_at": time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrCommentNotFound
+		}
+		var delta int64
+		if to == biz.CommentStatusApproved {
+			delta++
+		}
+		if from == biz.CommentStatusApproved {
+			delta--
+		}
+		return addCommentCount(tx, articleId, delta)
+	})
+	if err != nil {
+		if !biz.ErrCommentNotFound.Is(err) {
+			r.log.Errorf("ModerateComment error: %v", err)
+		}
+		return err
+	}
+	r.cache.del(ctx, articleId)
+	return nil
+}
+
+func (r *commentRepo) DeleteComment(ctx context.Context, id int64) error {
+	var articleId int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		model, err := r.get(tx, id)
+		if err != nil {
+			return err
+		}
+		articleId = model.ArticleId
+		scope := func() *gorm.DB {
+			return tx.Model(&comment{}).Where("id = ? OR path LIKE ?", id, model.subtree())
+		}
+		var approved int64
+		if err = scope().Where("status = ?", biz.CommentStatusApproved).Count(&approved).Error; err != nil {
+			return err
+		}
+		if err = scope().Delete(&comment{}).Error; err != nil {
+			return err
+		}
+		return addCommentCount(tx, articleId, -approved)
+	})
+	if err != nil {
+		if !biz.ErrCommentNotFound.Is(err) {
+			r.log.Errorf("DeleteComment error: %v", err)
+		}
+		return err
+	}
+	r.cache.del(ctx, articleId)
+	return nil
+}
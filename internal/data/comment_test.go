@@ -0,0 +1,256 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type commentFixture struct {
+	d        *Data
+	comments *biz.CommentUsecase
+	articles biz.ArticleRepo
+	article  int64 // 已发布的文章
+}
+
+func newCommentFixture(t *testing.T, maxDepth, maxReplies int32) *commentFixture {
+	t.Helper()
+	d, c, _ := newTestData(t)
+	c.Comment = &conf.Data_Comment{MaxDepth: maxDepth, MaxReplies: maxReplies}
+	searcher, err := NewArticleSearcher(c, d, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	articles := NewArticleRepo(c, d, log.DefaultLogger)
+	uc := biz.NewArticleUsecase(testAuth(), articles, NewLocker(d, log.DefaultLogger), searcher, log.DefaultLogger)
+	return &commentFixture{
+		d:        d,
+		comments: biz.NewCommentUsecase(c, NewCommentRepo(c, d, log.DefaultLogger), articles, log.DefaultLogger),
+		articles: articles,
+		article:  createPublished(t, uc, 1)[0],
+	}
+}
+
+// add 创建一条评论，approve 时随即通过审核
+func (f *commentFixture) add(t *testing.T, parent int64, approve bool) *biz.Comment {
+	t.Helper()
+	c := &biz.Comment{ArticleId: f.article, ParentId: parent, Author: "a", Content: "c"}
+	if err := f.comments.Create(context.Background(), c); err != nil {
+		t.Fatal(err)
+	}
+	if approve {
+		if _, err := f.comments.Moderate(context.Background(), c.Id, biz.CommentStatusApproved); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return c
+}
+
+func (f *commentFixture) commentCount(t *testing.T) int64 {
+	t.Helper()
+	a, err := f.articles.GetArticle(context.Background(), f.article)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a.CommentCount
+}
+
+func TestCommentPath(t *testing.T) {
+	f := newCommentFixture(t, 3, 0)
+	root := f.add(t, 0, true)
+	reply := f.add(t, root.Id, true)
+	nested := f.add(t, reply.Id, false)
+	other := f.add(t, 0, false)
+
+	tests := []struct {
+		id     int64
+		path   string
+		rootId int64
+		depth  int
+	}{
+		{root.Id, "/", 0, 0},
+		{reply.Id, fmt.Sprintf("/%d/", root.Id), root.Id, 1},
+		{nested.Id, fmt.Sprintf("/%d/%d/", root.Id, reply.Id), root.Id, 2},
+		{other.Id, "/", 0, 0},
+	}
+	for _, tt := range tests {
+		var m comment
+		if err := f.d.db.First(&m, tt.id).Error; err != nil {
+			t.Fatal(err)
+		}
+		if m.Path != tt.path || m.RootId != tt.rootId || m.Depth != tt.depth {
+			t.Fatalf("comment %d: path %q root %d depth %d, want %q %d %d", tt.id, m.Path, m.RootId, m.Depth, tt.path, tt.rootId, tt.depth)
+		}
+	}
+	if nested.Depth != 2 || nested.Status != biz.CommentStatusPending {
+		t.Fatalf("created comment = %+v", nested)
+	}
+}
+
+func TestCommentCreateRules(t *testing.T) {
+	f := newCommentFixture(t, 1, 0)
+	ctx := context.Background()
+	reply := f.add(t, f.add(t, 0, true).Id, true)
+	pending := f.add(t, 0, false)
+
+	tests := []struct {
+		name string
+		c    *biz.Comment
+		want interface{ Is(error) bool }
+	}{
+		{"too deep", &biz.Comment{ArticleId: f.article, ParentId: reply.Id}, biz.ErrCommentTooDeep},
+		{"pending parent", &biz.Comment{ArticleId: f.article, ParentId: pending.Id}, biz.ErrCommentNotFound},
+		{"missing parent", &biz.Comment{ArticleId: f.article, ParentId: 404}, biz.ErrCommentNotFound},
+		{"missing article", &biz.Comment{ArticleId: 404}, biz.ErrArticleNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := f.comments.Create(ctx, tt.c); !tt.want.Is(err) {
+				t.Fatalf("Create err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommentModeration(t *testing.T) {
+	f := newCommentFixture(t, 3, 0)
+	ctx := context.Background()
+	a := f.add(t, 0, false)
+	b := f.add(t, 0, false)
+	if n := f.commentCount(t); n != 0 {
+		t.Fatalf("pending comments counted: %d", n)
+	}
+
+	page, err := f.comments.ListPending(ctx, 1, "")
+	if err != nil || len(page.Comments) != 1 || page.Comments[0].Id != a.Id || page.NextPageToken == "" {
+		t.Fatalf("ListPending = %+v, %v", page, err)
+	}
+	page, err = f.comments.ListPending(ctx, 1, page.NextPageToken)
+	if err != nil || len(page.Comments) != 1 || page.Comments[0].Id != b.Id {
+		t.Fatalf("ListPending second page = %+v, %v", page, err)
+	}
+
+	for _, id := range []int64{a.Id, b.Id} {
+		if _, err = f.comments.Moderate(ctx, id, biz.CommentStatusApproved); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 重复审核为同一状态不重复计数
+	if _, err = f.comments.Moderate(ctx, a.Id, biz.CommentStatusApproved); err != nil {
+		t.Fatal(err)
+	}
+	if n := f.commentCount(t); n != 2 {
+		t.Fatalf("comment count = %d, want 2", n)
+	}
+	if _, err = f.comments.Moderate(ctx, b.Id, biz.CommentStatusRejected); err != nil {
+		t.Fatal(err)
+	}
+	if n := f.commentCount(t); n != 1 {
+		t.Fatalf("comment count after reject = %d, want 1", n)
+	}
+
+	// 状态已被并发修改时失败且不计数
+	repo := NewCommentRepo(&conf.Data{}, f.d, log.DefaultLogger)
+	if err = repo.ModerateComment(ctx, a.Id, biz.CommentStatusPending, biz.CommentStatusRejected); !biz.ErrCommentNotFound.Is(err) {
+		t.Fatalf("stale moderation err = %v", err)
+	}
+	if _, err = f.comments.Moderate(ctx, 404, biz.CommentStatusApproved); !biz.ErrCommentNotFound.Is(err) {
+		t.Fatalf("missing comment err = %v", err)
+	}
+	if n := f.commentCount(t); n != 1 {
+		t.Fatalf("comment count = %d, want 1", n)
+	}
+}
+
+func TestCommentListReplies(t *testing.T) {
+	f := newCommentFixture(t, 3, 3)
+	ctx := context.Background()
+	first := f.add(t, 0, true)
+	r1 := f.add(t, first.Id, true)
+	r2 := f.add(t, r1.Id, true)
+	hidden := f.add(t, first.Id, true)
+	f.add(t, hidden.Id, true) // 父评论被拒后不展示
+	f.add(t, first.Id, false)
+	f.add(t, first.Id, true) // 超出上限
+	second := f.add(t, 0, true)
+	s1 := f.add(t, second.Id, true)
+	if _, err := f.comments.Moderate(ctx, hidden.Id, biz.CommentStatusRejected); err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := f.comments.List(ctx, f.article, 10, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Comments) != 2 || page.Comments[0].Id != second.Id || page.Comments[1].Id != first.Id {
+		t.Fatalf("roots = %+v", page.Comments)
+	}
+	got := page.Comments[1]
+	if !got.MoreReplies || len(got.Replies) != 1 || got.Replies[0].Id != r1.Id ||
+		len(got.Replies[0].Replies) != 1 || got.Replies[0].Replies[0].Id != r2.Id {
+		t.Fatalf("first thread = %+v", got)
+	}
+	got = page.Comments[0]
+	if got.MoreReplies || len(got.Replies) != 1 || got.Replies[0].Id != s1.Id {
+		t.Fatalf("second thread = %+v", got)
+	}
+}
+
+func TestCommentDeleteSubtree(t *testing.T) {
+	f := newCommentFixture(t, 3, 0)
+	ctx := context.Background()
+	root := f.add(t, 0, true)
+	reply := f.add(t, root.Id, true)
+	f.add(t, reply.Id, true)
+	f.add(t, reply.Id, false)
+	sibling := f.add(t, root.Id, true)
+	if n := f.commentCount(t); n != 4 {
+		t.Fatalf("comment count = %d, want 4", n)
+	}
+
+	if err := f.comments.Delete(ctx, reply.Id); err != nil {
+		t.Fatal(err)
+	}
+	if n := f.commentCount(t); n != 2 {
+		t.Fatalf("comment count after delete = %d, want 2", n)
+	}
+	var left []int64
+	if err := f.d.db.Model(&comment{}).Order("id").Pluck("id", &left).Error; err != nil {
+		t.Fatal(err)
+	}
+	if !equalIds(left, []int64{root.Id, sibling.Id}) {
+		t.Fatalf("comments left = %v", left)
+	}
+	if err := f.comments.Delete(ctx, reply.Id); !biz.ErrCommentNotFound.Is(err) {
+		t.Fatalf("delete again err = %v", err)
+	}
+}
+
+func TestCommentAuthorFromCaller(t *testing.T) {
+	f := newCommentFixture(t, 3, 0)
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"anonymous", context.Background(), "guest"},
+		{"signed in", authorContext(7), "u7"},
+	}
+	for _, tt := range tests {
+		c := &biz.Comment{ArticleId: f.article, Author: "guest", Content: "c"}
+		if err := f.comments.Create(tt.ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		var got comment
+		if err := f.d.db.First(&got, c.Id).Error; err != nil {
+			t.Fatal(err)
+		}
+		if got.Author != tt.want {
+			t.Errorf("%s: author = %q, want %q", tt.name, got.Author, tt.want)
+		}
+	}
+}
@@ -3,9 +3,13 @@ package data
 import (
 	"agdemo/internal/conf"
 	"context"
+	"fmt"
+	"github.com/glebarez/sqlite"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -13,14 +17,18 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewGreeterRepo, NewArticleRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewGreeterRepo, NewArticleRepo, NewTaxonomyRepo, NewCommentRepo, NewUserRepo, NewApiKeyRepo, NewDependencyRepo, NewLocker, NewArticleSearcher)
 
 // Data .
 type Data struct {
 	// TODO wrapped database client
-	db  *gorm.DB
-	rdb *redis.Client
-	log *log.Helper
+	db      *gorm.DB
+	rdb     *redis.Client
+	log     *log.Helper
+	metrics *dataMetrics
+
+	// articles 各 repo 共用的文章缓存，回源合并和租约在同一个实例内才生效
+	articles *articleCache
 }
 
 // NewData .
@@ -31,9 +39,45 @@ type Data struct {
 //	return &Data{}, cleanup, nil
 //}
 
+// driverName 将配置中的 driver 规范化为 mysql、postgres 或 sqlite
+func driverName(c *conf.Data_Database) (string, error) {
+	switch c.Driver {
+	case "", "mysql":
+		return "mysql", nil
+	case "postgres", "postgresql":
+		return "postgres", nil
+	case "sqlite", "sqlite3":
+		return "sqlite", nil
+	default:
+		return "", fmt.Errorf("unsupported database driver: %q", c.Driver)
+	}
+}
+
+// dialector 按 driver 选择 gorm 方言，sqlite 为纯 Go 实现，无需 cgo
+func dialector(c *conf.Data_Database) (gorm.Dialector, error) {
+	driver, err := driverName(c)
+	if err != nil {
+		return nil, err
+	}
+	switch driver {
+	case "postgres":
+		return postgres.Open(c.Source), nil
+	case "sqlite":
+		return sqlite.Open(c.Source), nil
+	default:
+		return mysql.Open(c.Source), nil
+	}
+}
+
 // NewDB 初始化数据库连接
 func NewDB(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
-	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{
+	dial, err := dialector(c.Database)
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dial, &gorm.Config{
+		// 不开启 TranslateError：驱动会把唯一键冲突统一转成 gorm.ErrDuplicatedKey 并丢掉索引名，
+		// 冲突由 duplicateKey 按索引名识别
 		Logger: NewGormLogger(logger), // 自定义日志(见下文)
 	})
 	if err != nil {
@@ -49,6 +93,12 @@ func NewDB(c *conf.Data, logger log.Logger) (*gorm.DB, error) {
 	sqlDB.SetMaxIdleConns(int(c.Database.MaxIdleConns))
 	sqlDB.SetMaxOpenConns(int(c.Database.MaxOpenConns))
 	sqlDB.SetConnMaxLifetime(time.Hour)
+	if driver, _ := driverName(c.Database); driver == "sqlite" && strings.Contains(c.Database.Source, ":memory:") {
+		// 内存库每个连接各自独立，只能用单连接共享同一份数据
+		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+	}
 
 	return db, nil
 }
@@ -81,13 +131,27 @@ func NewRedis(c *conf.Data, logger log.Logger) (*redis.Client, error) {
 // NewData 整合所有数据源
 // data/data.go
 func NewData(c *conf.Data, db *gorm.DB, rdb *redis.Client, logger log.Logger) (*Data, func(), error) {
+	if c.Database.GetCheckSchema() {
+		if err := checkSchema(context.Background(), c, db, logger); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, nil, err
+	}
+	metrics, err := newDataMetrics(sqlDB, rdb)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		metrics.close()
 
 		// 关闭数据库连接
-		if sqlDB, err := db.DB(); err == nil {
-			_ = sqlDB.Close()
-		}
+		_ = sqlDB.Close()
 
 		// 关闭Redis连接
 		if rdb != nil {
@@ -96,8 +160,10 @@ func NewData(c *conf.Data, db *gorm.DB, rdb *redis.Client, logger log.Logger) (*
 	}
 
 	return &Data{
-		db:  db,
-		rdb: rdb,
-		log: log.NewHelper(logger),
+		db:       db,
+		rdb:      rdb,
+		log:      log.NewHelper(logger),
+		metrics:  metrics,
+		articles: newArticleCache(c, rdb, logger),
 	}, cleanup, nil
 }
@@ -0,0 +1,48 @@
+package data
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// newTestData 返回一个迁移到最新版本的 sqlite 内存库和 miniredis 组成的 Data
+func newTestData(t *testing.T) (*Data, *conf.Data, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Source: ":memory:"}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewMigrator(c, db, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	d, cleanup, err := NewData(c, db, rdb, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return d, c, mr
+}
+
+// testAuth 与 configs/config.yaml 相同的角色划分
+func testAuth() *conf.Auth {
+	return &conf.Auth{Authz: &conf.Auth_Authz{
+		DefaultRole: "author",
+		Roles: []*conf.Auth_Authz_Role{
+			{Name: "admin", ReadUnpublished: true, Operations: []string{"*"}},
+			{Name: "editor", ReadUnpublished: true, Operations: []string{"/blog.v1.BlogService/*"}},
+			{Name: "author", Operations: []string{"/blog.v1.BlogService/CreateArticle"}},
+		},
+	}}
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"errors"
+	"strings"
+
+	gosqlite "github.com/glebarez/go-sqlite"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// 迁移中定义的唯一索引名，三种驱动保持一致
+const (
+	ukArticleRevisionVersion = "uk_article_revision_version"
+	ukTagName                = "uk_tag_name"
+	ukCategoryName           = "uk_category_name"
+	ukUsersUsername          = "uk_users_username"
+	ukApiKeyPrefix           = "uk_api_key_prefix"
+)
+
+// uniqueKeyColumns sqlite 的报错只给出 "表.列"，按列反查索引名
+var uniqueKeyColumns = map[string]string{
+	"article_revision.article_id, article_revision.version": ukArticleRevisionVersion,
+	"tag.name":       ukTagName,
+	"category.name":  ukCategoryName,
+	"users.username": ukUsersUsername,
+	"api_key.prefix": ukApiKeyPrefix,
+}
+
+const (
+	mysqlErrDupEntry        = 1062
+	pgUniqueViolation       = "23505"
+	sqliteConstraintUnique  = 2067
+	sqliteConstraintPrimary = 1555
+)
+
+// duplicateKey 判断 err 是否为唯一键冲突，是则返回冲突的索引名；
+// 无法识别索引时 key 为空，调用方不应据此猜测是哪个字段冲突
+func duplicateKey(err error) (key string, ok bool) {
+	var me *mysql.MySQLError
+	if errors.As(err, &me) && me.Number == mysqlErrDupEntry {
+		// Duplicate entry 'x' for key 'table.uk_x'，5.7 及以前没有表名前缀
+		i := strings.LastIndex(me.Message, "for key '")
+		if i < 0 {
+			return "", true
+		}
+		key = strings.TrimSuffix(me.Message[i+len("for key '"):], "'")
+		if j := strings.LastIndexByte(key, '.'); j >= 0 {
+			key = key[j+1:]
+		}
+		return key, true
+	}
+	var pe *pgconn.PgError
+	if errors.As(err, &pe) && pe.Code == pgUniqueViolation {
+		return pe.ConstraintName, true
+	}
+	var se *gosqlite.Error
+	if errors.As(err, &se) && (se.Code() == sqliteConstraintUnique || se.Code() == sqliteConstraintPrimary) {
+		// UNIQUE constraint failed: table.col[, table.col] (2067)
+		msg := se.Error()
+		i := strings.LastIndex(msg, "failed: ")
+		if i < 0 {
+			return "", true
+		}
+		cols := msg[i+len("failed: "):]
+		if j := strings.LastIndex(cols, " ("); j >= 0 {
+			cols = cols[:j]
+		}
+		return uniqueKeyColumns[cols], true
+	}
+	return "", false
+}
+
+// isDuplicate 判断 err 是否为 key 索引上的唯一键冲突
+func isDuplicate(err error, key string) bool {
+	k, ok := duplicateKey(err)
+	return ok && k == key
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestDuplicateKey(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		key  string
+		ok   bool
+	}{
+		{"mysql8", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'go' for key 'tag.uk_tag_name'"}, ukTagName, true},
+		{"mysql57", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'go' for key 'uk_tag_name'"}, ukTagName, true},
+		{"mysql other", &mysql.MySQLError{Number: 1452, Message: "foreign key"}, "", false},
+		{"postgres", &pgconn.PgError{Code: "23505", ConstraintName: ukUsersUsername}, ukUsersUsername, true},
+		{"postgres other", &pgconn.PgError{Code: "23503"}, "", false},
+		{"wrapped", fmt.Errorf("create: %w", &pgconn.PgError{Code: "23505", ConstraintName: ukCategoryName}), ukCategoryName, true},
+		{"plain", fmt.Errorf("boom"), "", false},
+	}
+	for _, tt := range tests {
+		key, ok := duplicateKey(tt.err)
+		if key != tt.key || ok != tt.ok {
+			t.Errorf("%s: duplicateKey = %q %v, want %q %v", tt.name, key, ok, tt.key, tt.ok)
+		}
+	}
+}
+
+func TestDuplicateKeySqlite(t *testing.T) {
+	d, _, _ := newTestData(t)
+	db := d.db
+	if err := db.Create(&tag{Name: "go"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	err := db.Create(&tag{Name: "go"}).Error
+	if key, ok := duplicateKey(err); !ok || key != ukTagName {
+		t.Fatalf("duplicateKey(%v) = %q %v", err, key, ok)
+	}
+	if err = db.Create(&articleRevision{ArticleId: 1, Version: 1}).Error; err != nil {
+		t.Fatal(err)
+	}
+	err = db.Create(&articleRevision{ArticleId: 1, Version: 1}).Error
+	if key, ok := duplicateKey(err); !ok || key != ukArticleRevisionVersion {
+		t.Fatalf("duplicateKey(%v) = %q %v", err, key, ok)
+	}
+	if _, ok := translateErr(err).(*errors.Error); ok {
+		t.Fatal("revision conflict translated to a business error")
+	}
+}
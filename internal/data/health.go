@@ -0,0 +1,39 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+)
+
+type dependencyRepo struct {
+	data   *Data
+	driver string
+}
+
+// NewDependencyRepo 就绪检查访问的数据库与 redis，数据库以驱动名（如 mysql）命名
+func NewDependencyRepo(c *conf.Data, data *Data) biz.DependencyRepo {
+	driver, err := driverName(c.Database)
+	if err != nil {
+		driver = "database"
+	}
+	return &dependencyRepo{data: data, driver: driver}
+}
+
+func (r *dependencyRepo) Dependencies() []biz.Dependency {
+	deps := []biz.Dependency{{Name: r.driver, Ping: r.pingDB}}
+	if r.data.rdb != nil {
+		deps = append(deps, biz.Dependency{Name: "redis", Ping: func(ctx context.Context) error {
+			return r.data.rdb.Ping(ctx).Err()
+		}})
+	}
+	return deps
+}
+
+func (r *dependencyRepo) pingDB(ctx context.Context) error {
+	sqlDB, err := r.data.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
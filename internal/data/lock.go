@@ -0,0 +1,53 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+func lockKey(key string) string {
+	return "lock:" + key
+}
+
+// unlockScript 只删除自己持有的锁，租约过期后被他人拿到的锁不受影响
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type redisLocker struct {
+	rdb *redis.Client
+	log *log.Helper
+}
+
+// NewLocker returns a biz.Locker backed by redis SET NX PX.
+func NewLocker(data *Data, logger log.Logger) biz.Locker {
+	return &redisLocker{rdb: data.rdb, log: log.NewHelper(logger)}
+}
+
+func (l *redisLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, false, err
+	}
+	token := hex.EncodeToString(b)
+	ok, err := l.rdb.SetNX(ctx, lockKey(key), token, ttl).Result()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	unlock := func() {
+		// 调用方的 ctx 可能已取消，释放锁不应因此失败
+		if err := unlockScript.Run(context.Background(), l.rdb, []string{lockKey(key)}, token).Err(); err != nil {
+			l.log.Warnf("unlock %s error: %v", key, err)
+		}
+	}
+	return unlock, true, nil
+}
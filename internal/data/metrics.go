@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// meter 取自全局 MeterProvider，未配置导出时为空实现
+var meter = otel.Meter("agdemo/internal/data")
+
+var (
+	likeAttrs   = metric.WithAttributes(attribute.String("action", "like"))
+	unlikeAttrs = metric.WithAttributes(attribute.String("action", "unlike"))
+)
+
+// dataMetrics 点赞计数变化，以及数据库与 redis 连接池状态（采集时读取）
+type dataMetrics struct {
+	likeChanges  metric.Int64Counter
+	registration metric.Registration
+}
+
+func newDataMetrics(db *sql.DB, rdb *redis.Client) (*dataMetrics, error) {
+	likeChanges, err := meter.Int64Counter("article.like.changes",
+		metric.WithDescription("Like counter increments and decrements, by action"))
+	if err != nil {
+		return nil, err
+	}
+
+	dbConns, err := meter.Int64ObservableGauge("db.pool.connections",
+		metric.WithDescription("Open database connections, by state"))
+	if err != nil {
+		return nil, err
+	}
+	dbMaxOpen, err := meter.Int64ObservableGauge("db.pool.max_open",
+		metric.WithDescription("Maximum number of open database connections, 0 means unlimited"))
+	if err != nil {
+		return nil, err
+	}
+	dbWaits, err := meter.Int64ObservableCounter("db.pool.waits",
+		metric.WithDescription("Times a query waited for a free database connection"))
+	if err != nil {
+		return nil, err
+	}
+	dbWaitTime, err := meter.Float64ObservableCounter("db.pool.wait_duration", metric.WithUnit("s"),
+		metric.WithDescription("Total time spent waiting for a free database connection"))
+	if err != nil {
+		return nil, err
+	}
+	dbClosed, err := meter.Int64ObservableCounter("db.pool.closed",
+		metric.WithDescription("Database connections closed by the pool, by reason"))
+	if err != nil {
+		return nil, err
+	}
+
+	redisConns, err := meter.Int64ObservableGauge("redis.pool.connections",
+		metric.WithDescription("Open redis connections, by state"))
+	if err != nil {
+		return nil, err
+	}
+	redisGets, err := meter.Int64ObservableCounter("redis.pool.gets",
+		metric.WithDescription("Redis connection requests, by result"))
+	if err != nil {
+		return nil, err
+	}
+	redisStale, err := meter.Int64ObservableCounter("redis.pool.stale",
+		metric.WithDescription("Stale redis connections removed from the pool"))
+	if err != nil {
+		return nil, err
+	}
+
+	state := func(s string) metric.ObserveOption { return metric.WithAttributes(attribute.String("state", s)) }
+	reason := func(s string) metric.ObserveOption { return metric.WithAttributes(attribute.String("reason", s)) }
+	result := func(s string) metric.ObserveOption { return metric.WithAttributes(attribute.String("result", s)) }
+	reg, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		if db != nil {
+			s := db.Stats()
+			o.ObserveInt64(dbConns, int64(s.InUse), state("in_use"))
+			o.ObserveInt64(dbConns, int64(s.Idle), state("idle"))
+			o.ObserveInt64(dbMaxOpen, int64(s.MaxOpenConnections))
+			o.ObserveInt64(dbWaits, s.WaitCount)
+			o.ObserveFloat64(dbWaitTime, s.WaitDuration.Seconds())
+			o.ObserveInt64(dbClosed, s.MaxIdleClosed, reason("max_idle"))
+			o.ObserveInt64(dbClosed, s.MaxIdleTimeClosed, reason("max_idle_time"))
+			o.ObserveInt64(dbClosed, s.MaxLifetimeClosed, reason("max_lifetime"))
+		}
+		if rdb != nil {
+			s := rdb.PoolStats()
+			o.ObserveInt64(redisConns, int64(s.TotalConns-s.IdleConns), state("in_use"))
+			o.ObserveInt64(redisConns, int64(s.IdleConns), state("idle"))
+			o.ObserveInt64(redisGets, int64(s.Hits), result("hit"))
+			o.ObserveInt64(redisGets, int64(s.Misses), result("miss"))
+			o.ObserveInt64(redisGets, int64(s.Timeouts), result("timeout"))
+			o.ObserveInt64(redisStale, int64(s.StaleConns))
+		}
+		return nil
+	}, dbConns, dbMaxOpen, dbWaits, dbWaitTime, dbClosed, redisConns, redisGets, redisStale)
+	if err != nil {
+		return nil, err
+	}
+	return &dataMetrics{likeChanges: likeChanges, registration: reg}, nil
+}
+
+// close 停止采集连接池状态，连接关闭后不再读取
+func (m *dataMetrics) close() {
+	if err := m.registration.Unregister(); err != nil {
+		otel.Handle(err)
+	}
+}
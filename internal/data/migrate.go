@@ -0,0 +1,318 @@
+package data
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// migrations 按驱动分目录存放，文件名形如 0001_create_article.up.sql / 0001_create_article.down.sql
+//
+//go:embed migrations
+var migrations embed.FS
+
+// migrationTable 记录已执行的版本
+const migrationTable = "schema_migrations"
+
+// Migration 一个版本的升级与回滚脚本
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus 某个版本的执行状态
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type schemaMigration struct {
+	Version   int64 `gorm:"primaryKey"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// Migrator 执行内嵌的版本化 SQL 迁移
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	log        *log.Helper
+}
+
+// NewMigrator 加载当前驱动对应的迁移脚本
+func NewMigrator(c *conf.Data, db *gorm.DB, logger log.Logger) (*Migrator, error) {
+	driver, err := driverName(c.Database)
+	if err != nil {
+		return nil, err
+	}
+	ms, err := loadMigrations(driver)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: ms, log: log.NewHelper(logger)}, nil
+}
+
+func loadMigrations(driver string) ([]*Migration, error) {
+	dir := path.Join("migrations", driver)
+	entries, err := fs.ReadDir(migrations, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for driver %q: %w", driver, err)
+	}
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		name := e.Name()
+		base, up := strings.CutSuffix(name, ".up.sql")
+		if !up {
+			var down bool
+			if base, down = strings.CutSuffix(name, ".down.sql"); !down {
+				continue
+			}
+		}
+		ver, title, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name %q", name)
+		}
+		version, err := strconv.ParseInt(ver, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", name)
+		}
+		body, err := fs.ReadFile(migrations, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: title}
+			byVersion[version] = m
+		}
+		if up {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+	ms := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d has no up script", m.Version)
+		}
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Version < ms[j].Version })
+	return ms, nil
+}
+
+// splitStatements 按行尾分号拆分语句，mysql 驱动默认不支持一次执行多条
+func splitStatements(script string) []string {
+	var stmts []string
+	var b strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(b.String()))
+			b.Reset()
+		}
+	}
+	if s := strings.TrimSpace(b.String()); s != "" {
+		stmts = append(stmts, s)
+	}
+	return stmts
+}
+
+func (m *Migrator) ensureTable(ctx context.Context) error {
+	return m.db.WithContext(ctx).Exec(`CREATE TABLE IF NOT EXISTS ` + migrationTable + ` (
+    version    BIGINT       NOT NULL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMP    NOT NULL
+)`).Error
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	if err := m.ensureTable(ctx); err != nil {
+		return nil, err
+	}
+	var rows []schemaMigration
+	if err := m.db.WithContext(ctx).Table(migrationTable).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	res := make(map[int64]time.Time, len(rows))
+	for _, r := range rows {
+		res[r.Version] = r.AppliedAt
+	}
+	return res, nil
+}
+
+func (m *Migrator) run(ctx context.Context, mg *Migration, up bool) error {
+	script := mg.Down
+	if up {
+		script = mg.Up
+	}
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range splitStatements(script) {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		if up {
+			return tx.Table(migrationTable).Create(&schemaMigration{
+				Version:   mg.Version,
+				Name:      mg.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		}
+		return tx.Table(migrationTable).Where("version = ?", mg.Version).Delete(&schemaMigration{}).Error
+	})
+	if err != nil {
+		direction := "down"
+		if up {
+			direction = "up"
+		}
+		return fmt.Errorf("migration %d_%s %s: %w", mg.Version, mg.Name, direction, err)
+	}
+	return nil
+}
+
+// Latest 内嵌脚本中的最高版本
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status 列出每个版本及其是否已执行
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	done, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*MigrationStatus, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		at, ok := done[mg.Version]
+		res = append(res, &MigrationStatus{Migration: *mg, Applied: ok, AppliedAt: at})
+	}
+	return res, nil
+}
+
+// Pending 尚未执行的版本
+func (m *Migrator) Pending(ctx context.Context) ([]*Migration, error) {
+	done, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var res []*Migration
+	for _, mg := range m.migrations {
+		if _, ok := done[mg.Version]; !ok {
+			res = append(res, mg)
+		}
+	}
+	return res, nil
+}
+
+// Up 执行所有未执行的版本
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	return m.To(ctx, m.Latest())
+}
+
+// Down 回滚最近执行的一个版本，没有可回滚的版本时返回 nil
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	done, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mg := m.migrations[i]
+		if _, ok := done[mg.Version]; ok {
+			if mg.Down == "" {
+				return nil, fmt.Errorf("migration %d_%s has no down script", mg.Version, mg.Name)
+			}
+			return mg, m.run(ctx, mg, false)
+		}
+	}
+	return nil, nil
+}
+
+// To 升级或回滚到指定版本：不大于 version 的都执行，大于的都回滚，返回本次变动的版本
+func (m *Migrator) To(ctx context.Context, version int64) ([]*Migration, error) {
+	if version < 0 {
+		return nil, fmt.Errorf("invalid version %d", version)
+	}
+	if version != 0 && !m.known(version) {
+		return nil, fmt.Errorf("unknown migration version %d", version)
+	}
+	done, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var changed []*Migration
+	// 先从高到低回滚
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mg := m.migrations[i]
+		if _, ok := done[mg.Version]; !ok || mg.Version <= version {
+			continue
+		}
+		if mg.Down == "" {
+			return changed, fmt.Errorf("migration %d_%s has no down script", mg.Version, mg.Name)
+		}
+		if err := m.run(ctx, mg, false); err != nil {
+			return changed, err
+		}
+		changed = append(changed, mg)
+	}
+	// 再从低到高升级
+	for _, mg := range m.migrations {
+		if _, ok := done[mg.Version]; ok || mg.Version > version {
+			continue
+		}
+		if err := m.run(ctx, mg, true); err != nil {
+			return changed, err
+		}
+		m.log.Infof("migrated %d_%s", mg.Version, mg.Name)
+		changed = append(changed, mg)
+	}
+	return changed, nil
+}
+
+func (m *Migrator) known(version int64) bool {
+	for _, mg := range m.migrations {
+		if mg.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSchema 存在未执行的迁移时返回错误，用于拒绝在旧表结构上启动
+func checkSchema(ctx context.Context, c *conf.Data, db *gorm.DB, logger log.Logger) error {
+	m, err := NewMigrator(c, db, logger)
+	if err != nil {
+		return err
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("database schema is behind: %d pending migration(s) starting at %d_%s, run `agdemo migrate up`",
+			len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
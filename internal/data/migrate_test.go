@@ -0,0 +1,182 @@
+package data
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		script string
+		want   []string
+	}{
+		{"", nil},
+		{"-- only a comment\n\n", nil},
+		{"SELECT 1;", []string{"SELECT 1;"}},
+		{"SELECT 1;\nSELECT 2;\n", []string{"SELECT 1;", "SELECT 2;"}},
+		{"CREATE TABLE t (\n    id INT\n);\n-- note\nDROP TABLE t;", []string{"CREATE TABLE t (\n    id INT\n);", "DROP TABLE t;"}},
+		{"SELECT 1", []string{"SELECT 1"}}, // 缺少结尾分号
+	}
+	for _, tt := range tests {
+		if got := splitStatements(tt.script); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitStatements(%q) = %q, want %q", tt.script, got, tt.want)
+		}
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	var versions []int64
+	for _, driver := range []string{"mysql", "postgres", "sqlite"} {
+		ms, err := loadMigrations(driver)
+		if err != nil {
+			t.Fatalf("%s: %v", driver, err)
+		}
+		var vs []int64
+		for i, m := range ms {
+			if m.Version != int64(i+1) {
+				t.Fatalf("%s: migration #%d has version %d", driver, i, m.Version)
+			}
+			if m.Up == "" || m.Down == "" {
+				t.Fatalf("%s: migration %d_%s lacks a script", driver, m.Version, m.Name)
+			}
+			// FULLTEXT 索引只在 mysql 上由迁移创建，mysqlSearcher 启动时只检查是否存在
+			if m.Name == "article_fulltext" && (len(splitStatements(m.Up)) > 0) != (driver == "mysql") {
+				t.Fatalf("%s: migration %d_%s should create the fulltext index only on mysql", driver, m.Version, m.Name)
+			}
+			vs = append(vs, m.Version)
+		}
+		if versions == nil {
+			versions = vs
+		} else if !reflect.DeepEqual(vs, versions) {
+			t.Fatalf("%s versions %v differ from %v", driver, vs, versions)
+		}
+	}
+	if _, err := loadMigrations("oracle"); err == nil {
+		t.Fatal("expected an error for an unknown driver")
+	}
+}
+
+func newTestMigrator(t *testing.T, ms ...*Migration) *Migrator {
+	t.Helper()
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Source: ":memory:"}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Migrator{db: db, migrations: ms, log: log.NewHelper(log.DefaultLogger)}
+}
+
+func versionsOf(ms []*Migration) []int64 {
+	vs := make([]int64, 0, len(ms))
+	for _, m := range ms {
+		vs = append(vs, m.Version)
+	}
+	return vs
+}
+
+func TestMigratorTo(t *testing.T) {
+	ctx := context.Background()
+	m := newTestMigrator(t,
+		&Migration{Version: 1, Name: "a", Up: "CREATE TABLE a (id INTEGER);", Down: "DROP TABLE a;"},
+		&Migration{Version: 2, Name: "b", Up: "CREATE TABLE b (id INTEGER);", Down: "DROP TABLE b;"},
+		&Migration{Version: 3, Name: "c", Up: "CREATE TABLE c (id INTEGER);", Down: "DROP TABLE c;"},
+	)
+
+	changed, err := m.To(ctx, 2)
+	if err != nil || !reflect.DeepEqual(versionsOf(changed), []int64{1, 2}) {
+		t.Fatalf("To(2) = %v %v", versionsOf(changed), err)
+	}
+	changed, err = m.Up(ctx)
+	if err != nil || !reflect.DeepEqual(versionsOf(changed), []int64{3}) {
+		t.Fatalf("Up = %v %v", versionsOf(changed), err)
+	}
+	// 回滚从高到低
+	changed, err = m.To(ctx, 1)
+	if err != nil || !reflect.DeepEqual(versionsOf(changed), []int64{3, 2}) {
+		t.Fatalf("To(1) = %v %v", versionsOf(changed), err)
+	}
+	if !m.db.Migrator().HasTable("a") || m.db.Migrator().HasTable("b") {
+		t.Fatal("tables do not match version 1")
+	}
+	down, err := m.Down(ctx)
+	if err != nil || down.Version != 1 {
+		t.Fatalf("Down = %v %v", down, err)
+	}
+	if down, err = m.Down(ctx); err != nil || down != nil {
+		t.Fatalf("Down on empty = %v %v", down, err)
+	}
+	if _, err = m.To(ctx, 9); err == nil {
+		t.Fatal("expected an error for an unknown version")
+	}
+}
+
+func TestMigratorFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	m := newTestMigrator(t,
+		&Migration{Version: 1, Name: "a", Up: "CREATE TABLE a (id INTEGER);", Down: "DROP TABLE a;"},
+		&Migration{Version: 2, Name: "broken", Up: "CREATE TABLE b (id INTEGER);\nNOT SQL;", Down: "DROP TABLE b;"},
+	)
+
+	changed, err := m.Up(ctx)
+	if err == nil {
+		t.Fatal("expected the broken migration to fail")
+	}
+	if !reflect.DeepEqual(versionsOf(changed), []int64{1}) {
+		t.Fatalf("changed = %v", versionsOf(changed))
+	}
+	// 失败的版本整体回滚且不记录，修复后可以重跑
+	if m.db.Migrator().HasTable("b") {
+		t.Fatal("failed migration left a partial schema")
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil || !reflect.DeepEqual(versionsOf(pending), []int64{2}) {
+		t.Fatalf("pending = %v %v", versionsOf(pending), err)
+	}
+	m.migrations[1].Up = "CREATE TABLE b (id INTEGER);"
+	if changed, err = m.Up(ctx); err != nil || !reflect.DeepEqual(versionsOf(changed), []int64{2}) {
+		t.Fatalf("retry = %v %v", versionsOf(changed), err)
+	}
+}
+
+func TestCheckSchema(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Source: ":memory:"}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = checkSchema(ctx, c, db, log.DefaultLogger); err == nil {
+		t.Fatal("expected pending migrations to be reported")
+	}
+	m, _ := NewMigrator(c, db, log.DefaultLogger)
+	if _, err = m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err = checkSchema(ctx, c, db, log.DefaultLogger); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateDuplicateTitles(t *testing.T) {
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Source: ":memory:"}}
+	db, err := NewDB(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	m, _ := NewMigrator(c, db, log.DefaultLogger)
+	if _, err = m.To(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	// 已有的 article 表中可能有重名的文章，之后的迁移不能因此失败
+	if err = db.Exec("INSERT INTO article (title, content) VALUES ('same', 'a'), ('same', 'b')").Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.Up(ctx); err != nil {
+		t.Fatalf("migrate with duplicate titles: %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `article` (
+    `id`         BIGINT       NOT NULL AUTO_INCREMENT,
+    `title`      VARCHAR(100) NOT NULL DEFAULT '',
+    `content`    TEXT,
+    `like_count` BIGINT       NOT NULL DEFAULT 0,
+    `created_at` DATETIME(3)  NULL,
+    `updated_at` DATETIME(3)  NULL,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
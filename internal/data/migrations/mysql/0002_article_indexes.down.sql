@@ -0,0 +1,3 @@
+DROP INDEX `idx_article_like_count` ON `article`;
+DROP INDEX `idx_article_updated_at` ON `article`;
+DROP INDEX `idx_article_created_at` ON `article`;
@@ -0,0 +1,3 @@
+CREATE INDEX `idx_article_created_at` ON `article` (`created_at`, `id`);
+CREATE INDEX `idx_article_updated_at` ON `article` (`updated_at`, `id`);
+CREATE INDEX `idx_article_like_count` ON `article` (`like_count`, `id`);
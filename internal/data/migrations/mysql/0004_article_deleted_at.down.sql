@@ -0,0 +1,2 @@
+DROP INDEX `idx_article_deleted_at` ON `article`;
+ALTER TABLE `article` DROP COLUMN `deleted_at`;
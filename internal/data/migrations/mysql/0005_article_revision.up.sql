@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `article_revision` (
+    `id`         BIGINT       NOT NULL AUTO_INCREMENT,
+    `article_id` BIGINT       NOT NULL,
+    `version`    BIGINT       NOT NULL,
+    `title`      VARCHAR(100) NOT NULL DEFAULT '',
+    `content`    TEXT,
+    `author`     VARCHAR(64)  NOT NULL DEFAULT '',
+    `created_at` DATETIME(3)  NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_article_revision_version` (`article_id`, `version`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+INSERT INTO `article_revision` (`article_id`, `version`, `title`, `content`, `author`, `created_at`)
+SELECT `id`, `version`, `title`, `content`, '', `updated_at` FROM `article`;
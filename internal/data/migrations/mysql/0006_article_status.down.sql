@@ -0,0 +1,4 @@
+DROP INDEX `idx_article_status_publish_at` ON `article`;
+ALTER TABLE `article` DROP COLUMN `published_at`;
+ALTER TABLE `article` DROP COLUMN `publish_at`;
+ALTER TABLE `article` DROP COLUMN `status`;
@@ -0,0 +1,5 @@
+DROP INDEX `idx_article_category_id` ON `article`;
+ALTER TABLE `article` DROP COLUMN `category_id`;
+DROP TABLE IF EXISTS `category`;
+DROP TABLE IF EXISTS `article_tag`;
+DROP TABLE IF EXISTS `tag`;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS `tag` (
+    `id`         BIGINT      NOT NULL AUTO_INCREMENT,
+    `name`       VARCHAR(32) NOT NULL,
+    `created_at` DATETIME(3) NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_tag_name` (`name`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+CREATE TABLE IF NOT EXISTS `article_tag` (
+    `article_id` BIGINT NOT NULL,
+    `tag_id`     BIGINT NOT NULL,
+    PRIMARY KEY (`article_id`, `tag_id`),
+    KEY `idx_article_tag_tag_id` (`tag_id`, `article_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+CREATE TABLE IF NOT EXISTS `category` (
+    `id`          BIGINT       NOT NULL AUTO_INCREMENT,
+    `name`        VARCHAR(50)  NOT NULL,
+    `description` VARCHAR(200) NOT NULL DEFAULT '',
+    `created_at`  DATETIME(3)  NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_category_name` (`name`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+ALTER TABLE `article` ADD COLUMN `category_id` BIGINT NULL;
+CREATE INDEX `idx_article_category_id` ON `article` (`category_id`);
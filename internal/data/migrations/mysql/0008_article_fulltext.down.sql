@@ -0,0 +1 @@
+DROP INDEX `ft_article_title_content` ON `article`;
@@ -0,0 +1,2 @@
+-- ngram 分词同时支持中英文，供 search.driver=mysql 使用
+CREATE FULLTEXT INDEX `ft_article_title_content` ON `article` (`title`, `content`) WITH PARSER ngram;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS `comment` (
+    `id`         BIGINT        NOT NULL AUTO_INCREMENT,
+    `article_id` BIGINT        NOT NULL,
+    `parent_id`  BIGINT        NOT NULL DEFAULT 0,
+    `root_id`    BIGINT        NOT NULL DEFAULT 0,
+    `depth`      INT           NOT NULL DEFAULT 0,
+    `path`       VARCHAR(255)  NOT NULL DEFAULT '/',
+    `author`     VARCHAR(64)   NOT NULL DEFAULT '',
+    `content`    VARCHAR(1000) NOT NULL DEFAULT '',
+    `status`     VARCHAR(16)   NOT NULL DEFAULT 'pending',
+    `created_at` DATETIME(3)   NULL,
+    `updated_at` DATETIME(3)   NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_comment_article_root` (`article_id`, `parent_id`, `status`, `id`),
+    KEY `idx_comment_root_id` (`root_id`, `status`),
+    KEY `idx_comment_status_id` (`status`, `id`),
+    KEY `idx_comment_path` (`path`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+ALTER TABLE `article` ADD COLUMN `comment_count` BIGINT NOT NULL DEFAULT 0;
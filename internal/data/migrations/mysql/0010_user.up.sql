@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `users` (
+    `id`            BIGINT       NOT NULL AUTO_INCREMENT,
+    `username`      VARCHAR(32)  NOT NULL,
+    `password_hash` VARCHAR(255) NOT NULL,
+    `display_name`  VARCHAR(64)  NOT NULL DEFAULT '',
+    `bio`           VARCHAR(500) NOT NULL DEFAULT '',
+    `created_at`    DATETIME(3)  NULL,
+    `updated_at`    DATETIME(3)  NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_users_username` (`username`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+-- articles written before accounts existed keep author_id 0
+ALTER TABLE `article` ADD COLUMN `author_id` BIGINT NOT NULL DEFAULT 0;
+CREATE INDEX `idx_article_author_id` ON `article` (`author_id`);
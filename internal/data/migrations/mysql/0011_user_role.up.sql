@@ -0,0 +1,2 @@
+-- users registered before roles existed become authors
+ALTER TABLE `users` ADD COLUMN `role` VARCHAR(32) NOT NULL DEFAULT 'author';
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `api_key` (
+    `id`           BIGINT        NOT NULL AUTO_INCREMENT,
+    `name`         VARCHAR(64)   NOT NULL,
+    `prefix`       VARCHAR(16)   NOT NULL,
+    `key_hash`     CHAR(64)      NOT NULL,
+    `scopes`       VARCHAR(4000) NOT NULL DEFAULT '',
+    `created_by`   BIGINT        NOT NULL DEFAULT 0,
+    `created_at`   DATETIME(3)   NULL,
+    `expires_at`   DATETIME(3)   NULL,
+    `last_used_at` DATETIME(3)   NULL,
+    `revoked_at`   DATETIME(3)   NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_api_key_prefix` (`prefix`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE `article` ADD COLUMN `view_count` BIGINT NOT NULL DEFAULT 0;
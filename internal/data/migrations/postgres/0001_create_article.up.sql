@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS article (
+    id         BIGSERIAL PRIMARY KEY,
+    title      VARCHAR(100) NOT NULL DEFAULT '',
+    content    TEXT,
+    like_count BIGINT       NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_article_deleted_at;
+ALTER TABLE article DROP COLUMN deleted_at;
@@ -0,0 +1,2 @@
+ALTER TABLE article ADD COLUMN deleted_at TIMESTAMPTZ;
+CREATE INDEX idx_article_deleted_at ON article (deleted_at, id);
@@ -0,0 +1,6 @@
+ALTER TABLE article ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'draft';
+ALTER TABLE article ADD COLUMN publish_at TIMESTAMPTZ;
+ALTER TABLE article ADD COLUMN published_at TIMESTAMPTZ;
+-- 已有文章在引入工作流前都是公开的
+UPDATE article SET status = 'published', published_at = created_at;
+CREATE INDEX idx_article_status_publish_at ON article (status, publish_at);
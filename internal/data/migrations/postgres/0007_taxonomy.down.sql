@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_article_category_id;
+ALTER TABLE article DROP COLUMN category_id;
+DROP TABLE IF EXISTS category;
+DROP TABLE IF EXISTS article_tag;
+DROP TABLE IF EXISTS tag;
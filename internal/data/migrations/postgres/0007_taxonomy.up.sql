@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS tag (
+    id         BIGSERIAL PRIMARY KEY,
+    name       VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX uk_tag_name ON tag (name);
+CREATE TABLE IF NOT EXISTS article_tag (
+    article_id BIGINT NOT NULL,
+    tag_id     BIGINT NOT NULL,
+    PRIMARY KEY (article_id, tag_id)
+);
+CREATE INDEX idx_article_tag_tag_id ON article_tag (tag_id, article_id);
+CREATE TABLE IF NOT EXISTS category (
+    id          BIGSERIAL PRIMARY KEY,
+    name        VARCHAR(50)  NOT NULL,
+    description VARCHAR(200) NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX uk_category_name ON category (name);
+ALTER TABLE article ADD COLUMN category_id BIGINT;
+CREATE INDEX idx_article_category_id ON article (category_id);
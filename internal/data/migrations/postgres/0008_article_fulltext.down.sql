@@ -0,0 +1 @@
+-- FULLTEXT 索引只用于 mysql，其他驱动保持版本号一致
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS comment (
+    id         BIGSERIAL PRIMARY KEY,
+    article_id BIGINT        NOT NULL,
+    parent_id  BIGINT        NOT NULL DEFAULT 0,
+    root_id    BIGINT        NOT NULL DEFAULT 0,
+    depth      INT           NOT NULL DEFAULT 0,
+    path       VARCHAR(255)  NOT NULL DEFAULT '/',
+    author     VARCHAR(64)   NOT NULL DEFAULT '',
+    content    VARCHAR(1000) NOT NULL DEFAULT '',
+    status     VARCHAR(16)   NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ
+);
+CREATE INDEX idx_comment_article_root ON comment (article_id, parent_id, status, id);
+CREATE INDEX idx_comment_root_id ON comment (root_id, status);
+CREATE INDEX idx_comment_status_id ON comment (status, id);
+CREATE INDEX idx_comment_path ON comment (path);
+ALTER TABLE article ADD COLUMN comment_count BIGINT NOT NULL DEFAULT 0;
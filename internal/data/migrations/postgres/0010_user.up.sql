@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS users (
+    id            BIGSERIAL PRIMARY KEY,
+    username      VARCHAR(32)  NOT NULL,
+    password_hash VARCHAR(255) NOT NULL,
+    display_name  VARCHAR(64)  NOT NULL DEFAULT '',
+    bio           VARCHAR(500) NOT NULL DEFAULT '',
+    created_at    TIMESTAMPTZ,
+    updated_at    TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX uk_users_username ON users (username);
+-- articles written before accounts existed keep author_id 0
+ALTER TABLE article ADD COLUMN author_id BIGINT NOT NULL DEFAULT 0;
+CREATE INDEX idx_article_author_id ON article (author_id);
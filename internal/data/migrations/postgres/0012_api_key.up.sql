@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS api_key (
+    id           BIGSERIAL PRIMARY KEY,
+    name         VARCHAR(64)   NOT NULL,
+    prefix       VARCHAR(16)   NOT NULL,
+    key_hash     CHAR(64)      NOT NULL,
+    scopes       VARCHAR(4000) NOT NULL DEFAULT '',
+    created_by   BIGINT        NOT NULL DEFAULT 0,
+    created_at   TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX uk_api_key_prefix ON api_key (prefix);
@@ -0,0 +1,7 @@
+-- 点赞用户，由 like flusher 从 redis 回写，redis 丢失数据后据此重建点赞集合
+CREATE TABLE IF NOT EXISTS article_like (
+    article_id BIGINT NOT NULL,
+    user_id    BIGINT NOT NULL,
+    created_at TIMESTAMPTZ,
+    PRIMARY KEY (article_id, user_id)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS article (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    title      VARCHAR(100) NOT NULL DEFAULT '',
+    content    TEXT,
+    like_count INTEGER      NOT NULL DEFAULT 0,
+    created_at DATETIME,
+    updated_at DATETIME
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_article_like_count;
+DROP INDEX IF EXISTS idx_article_updated_at;
+DROP INDEX IF EXISTS idx_article_created_at;
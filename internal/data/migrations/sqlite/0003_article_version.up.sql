@@ -0,0 +1 @@
+ALTER TABLE article ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS article_revision (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    article_id INTEGER      NOT NULL,
+    version    BIGINT       NOT NULL,
+    title      VARCHAR(100) NOT NULL DEFAULT '',
+    content    TEXT,
+    author     VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at DATETIME
+);
+CREATE UNIQUE INDEX uk_article_revision_version ON article_revision (article_id, version);
+INSERT INTO article_revision (article_id, version, title, content, author, created_at)
+SELECT id, version, title, content, '', updated_at FROM article;
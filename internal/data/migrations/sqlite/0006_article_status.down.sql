@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_article_status_publish_at;
+ALTER TABLE article DROP COLUMN published_at;
+ALTER TABLE article DROP COLUMN publish_at;
+ALTER TABLE article DROP COLUMN status;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS tag (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    name       VARCHAR(32) NOT NULL,
+    created_at DATETIME
+);
+CREATE UNIQUE INDEX uk_tag_name ON tag (name);
+CREATE TABLE IF NOT EXISTS article_tag (
+    article_id INTEGER NOT NULL,
+    tag_id     INTEGER NOT NULL,
+    PRIMARY KEY (article_id, tag_id)
+);
+CREATE INDEX idx_article_tag_tag_id ON article_tag (tag_id, article_id);
+CREATE TABLE IF NOT EXISTS category (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    name        VARCHAR(50)  NOT NULL,
+    description VARCHAR(200) NOT NULL DEFAULT '',
+    created_at  DATETIME
+);
+CREATE UNIQUE INDEX uk_category_name ON category (name);
+ALTER TABLE article ADD COLUMN category_id INTEGER;
+CREATE INDEX idx_article_category_id ON article (category_id);
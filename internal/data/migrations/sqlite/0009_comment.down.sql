@@ -0,0 +1,2 @@
+ALTER TABLE article DROP COLUMN comment_count;
+DROP TABLE IF EXISTS comment;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS api_key (
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    name         VARCHAR(64)   NOT NULL,
+    prefix       VARCHAR(16)   NOT NULL,
+    key_hash     CHAR(64)      NOT NULL,
+    scopes       VARCHAR(4000) NOT NULL DEFAULT '',
+    created_by   INTEGER       NOT NULL DEFAULT 0,
+    created_at   DATETIME,
+    expires_at   DATETIME,
+    last_used_at DATETIME,
+    revoked_at   DATETIME
+);
+CREATE UNIQUE INDEX uk_api_key_prefix ON api_key (prefix);
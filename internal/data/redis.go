@@ -1,26 +1,385 @@
 package data
 
 import (
+	"agdemo/internal/biz"
 	"context"
 	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
+	"time"
 )
 
+// articleLike 已回写数据库的点赞用户，redis 丢失点赞集合后据此重建
+type articleLike struct {
+	ArticleId int64     `gorm:"primaryKey;autoIncrement:false"`
+	UserId    int64     `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (articleLike) TableName() string {
+	return "article_like"
+}
+
+// likeDirtyKey 计数发生变化、尚未回写数据库的文章 id 集合
+const likeDirtyKey = "like:dirty"
+
 func likeKey(id int64) string {
 	return fmt.Sprintf("like:%d", id)
 }
 
+// likeUsersKey 点赞用户集合，用于按用户去重
+func likeUsersKey(id int64) string {
+	return fmt.Sprintf("like:users:%d", id)
+}
+
+// likePendingKey 尚未回写数据库的点赞变化，user id -> "1" 点赞 / "0" 取消
+func likePendingKey(id int64) string {
+	return fmt.Sprintf("like:pending:%d", id)
+}
+
+// viewDirtyKey 浏览数发生变化、尚未回写数据库的文章 id 集合
+const viewDirtyKey = "view:dirty"
+
+func viewKey(id int64) string {
+	return fmt.Sprintf("view:%d", id)
+}
+
+// likeScript 用户首次点赞时计数 +1、记录变化并标记为脏，返回 {当前计数, 是否变化}
+var likeScript = redis.NewScript(`
+if redis.call('SADD', KEYS[1], ARGV[1]) == 1 then
+	redis.call('HSET', KEYS[4], ARGV[1], '1')
+	redis.call('SADD', KEYS[3], ARGV[2])
+	return {redis.call('INCR', KEYS[2]), 1}
+end
+return {tonumber(redis.call('GET', KEYS[2]) or '0'), 0}
+`)
+
+// viewScript 浏览数 +1 并标记为脏，返回当前浏览数
+var viewScript = redis.NewScript(`
+redis.call('SADD', KEYS[2], ARGV[1])
+return redis.call('INCR', KEYS[1])
+`)
+
+// unlikeScript 用户已点赞时计数 -1、记录变化并标记为脏，返回 {当前计数, 是否变化}
+var unlikeScript = redis.NewScript(`
+if redis.call('SREM', KEYS[1], ARGV[1]) == 1 then
+	redis.call('HSET', KEYS[4], ARGV[1], '0')
+	redis.call('SADD', KEYS[3], ARGV[2])
+	return {redis.call('DECR', KEYS[2]), 1}
+end
+return {tonumber(redis.call('GET', KEYS[2]) or '0'), 0}
+`)
+
+// seedLikeScript 计数不存在时用数据库中的点赞数和点赞用户初始化，并发初始化只有第一次生效，返回当前计数
+var seedLikeScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	redis.call('SET', KEYS[1], ARGV[1])
+	for i = 2, #ARGV do
+		redis.call('SADD', KEYS[2], ARGV[i])
+	end
+end
+return tonumber(redis.call('GET', KEYS[1]))
+`)
+
+// takeLikeScript 取出点赞数和待回写的点赞变化，返回 {计数, user, flag, user, flag, ...}，计数不存在时为空串
+var takeLikeScript = redis.NewScript(`
+local rv = {redis.call('GET', KEYS[1]) or ''}
+for _, v in ipairs(redis.call('HGETALL', KEYS[2])) do
+	rv[#rv + 1] = v
+end
+redis.call('DEL', KEYS[2])
+return rv
+`)
+
+// seedCounter redis 丢失计数（如重启）时用数据库中 column 列的值重新初始化 key，目前用于浏览数
+func (ar *articleRepo) seedCounter(ctx context.Context, id int64, key, column string) (int64, error) {
+	var ns []int64
+	if err := ar.data.db.WithContext(ctx).Model(&article{}).Where("id = ?", id).Limit(1).Pluck(column, &ns).Error; err != nil {
+		return 0, translateErr(err)
+	}
+	if len(ns) == 0 {
+		return 0, biz.ErrArticleNotFound
+	}
+	if err := ar.data.rdb.SetNX(ctx, key, ns[0], 0).Err(); err != nil {
+		ar.log.Errorf("seedCounter %s error: %v", key, err)
+		return 0, biz.ErrViewStoreUnavailable.WithCause(err)
+	}
+	return ar.data.rdb.Get(ctx, key).Int64()
+}
+
+// ensureCounter key 不存在时先从数据库初始化
+func (ar *articleRepo) ensureCounter(ctx context.Context, id int64, key, column string) error {
+	n, err := ar.data.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		ar.log.Errorf("ensureCounter %s error: %v", key, err)
+		return biz.ErrViewStoreUnavailable.WithCause(err)
+	}
+	if n == 0 {
+		_, err = ar.seedCounter(ctx, id, key, column)
+	}
+	return err
+}
+
+// seedArticleLike redis 丢失点赞数据（如重启）时用数据库中的 like_count 和 article_like 重建计数与点赞集合
+func (ar *articleRepo) seedArticleLike(ctx context.Context, id int64) (int64, error) {
+	var ns []int64
+	if err := ar.data.db.WithContext(ctx).Model(&article{}).Where("id = ?", id).Limit(1).Pluck("like_count", &ns).Error; err != nil {
+		return 0, translateErr(err)
+	}
+	if len(ns) == 0 {
+		return 0, biz.ErrArticleNotFound
+	}
+	var users []int64
+	if err := ar.data.db.WithContext(ctx).Model(&articleLike{}).Where("article_id = ?", id).Pluck("user_id", &users).Error; err != nil {
+		return 0, err
+	}
+	args := make([]interface{}, 0, len(users)+1)
+	args = append(args, ns[0])
+	for _, u := range users {
+		args = append(args, u)
+	}
+	n, err := seedLikeScript.Run(ctx, ar.data.rdb, []string{likeKey(id), likeUsersKey(id)}, args...).Int64()
+	if err != nil {
+		ar.log.Errorf("seedArticleLike error: %v", err)
+		return 0, biz.ErrLikeStoreUnavailable.WithCause(err)
+	}
+	return n, nil
+}
+
+func (ar *articleRepo) ensureArticleLike(ctx context.Context, id int64) error {
+	n, err := ar.data.rdb.Exists(ctx, likeKey(id)).Result()
+	if err != nil {
+		ar.log.Errorf("ensureArticleLike error: %v", err)
+		return biz.ErrLikeStoreUnavailable.WithCause(err)
+	}
+	if n == 0 {
+		_, err = ar.seedArticleLike(ctx, id)
+	}
+	return err
+}
+
 func (ar *articleRepo) GetArticleLike(ctx context.Context, id int64) (rv int64, err error) {
 	get := ar.data.rdb.Get(ctx, likeKey(id))
 	rv, err = get.Int64()
 	if errors.Is(err, redis.Nil) {
-		return 0, nil
+		return ar.seedArticleLike(ctx, id)
+	}
+	if err != nil {
+		ar.log.Errorf("GetArticleLike error: %v", err)
+		return 0, biz.ErrLikeStoreUnavailable.WithCause(err)
 	}
 	return
 }
 
-func (ar *articleRepo) IncArticleLike(ctx context.Context, id int64) error {
-	_, err := ar.data.rdb.Incr(ctx, likeKey(id)).Result()
-	return err
+func (ar *articleRepo) LikeArticle(ctx context.Context, id int64, userId int64) (int64, error) {
+	if err := ar.ensureArticleLike(ctx, id); err != nil {
+		return 0, err
+	}
+	keys := []string{likeUsersKey(id), likeKey(id), likeDirtyKey, likePendingKey(id)}
+	rv, err := likeScript.Run(ctx, ar.data.rdb, keys, userId, id).Int64Slice()
+	if err != nil {
+		ar.log.Errorf("LikeArticle error: %v", err)
+		return 0, biz.ErrLikeStoreUnavailable.WithCause(err)
+	}
+	if rv[1] == 1 {
+		ar.data.metrics.likeChanges.Add(ctx, 1, likeAttrs)
+	}
+	return rv[0], nil
+}
+
+func (ar *articleRepo) UnlikeArticle(ctx context.Context, id int64, userId int64) (int64, error) {
+	if err := ar.ensureArticleLike(ctx, id); err != nil {
+		return 0, err
+	}
+	keys := []string{likeUsersKey(id), likeKey(id), likeDirtyKey, likePendingKey(id)}
+	rv, err := unlikeScript.Run(ctx, ar.data.rdb, keys, userId, id).Int64Slice()
+	if err != nil {
+		ar.log.Errorf("UnlikeArticle error: %v", err)
+		return 0, biz.ErrLikeStoreUnavailable.WithCause(err)
+	}
+	if rv[1] == 1 {
+		ar.data.metrics.likeChanges.Add(ctx, 1, unlikeAttrs)
+	}
+	return rv[0], nil
+}
+
+func (ar *articleRepo) IncArticleView(ctx context.Context, id int64) (int64, error) {
+	if err := ar.ensureCounter(ctx, id, viewKey(id), "view_count"); err != nil {
+		return 0, err
+	}
+	rv, err := viewScript.Run(ctx, ar.data.rdb, []string{viewKey(id), viewDirtyKey}, id).Int64()
+	if err != nil {
+		ar.log.Errorf("IncArticleView error: %v", err)
+		return 0, biz.ErrViewStoreUnavailable.WithCause(err)
+	}
+	return rv, nil
+}
+
+// removeArticleState 文章被彻底删除后清理其在 redis 中的计数、点赞用户与缓存
+func (ar *articleRepo) removeArticleState(ctx context.Context, id int64) {
+	pipe := ar.data.rdb.TxPipeline()
+	pipe.Del(ctx, likeKey(id), likeUsersKey(id), likePendingKey(id), viewKey(id), articleCacheKey(id))
+	pipe.SRem(ctx, likeDirtyKey, id)
+	pipe.SRem(ctx, viewDirtyKey, id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		ar.log.Warnf("removeArticleState error: %v", err)
+	}
+}
+
+// likeChanges 一篇文章待回写的点赞数和点赞变化
+type likeChanges struct {
+	id    int64
+	count string // 空串表示计数已被删除
+	users map[int64]bool
+}
+
+// FlushArticleLikes 取出至多 batch 篇脏文章，把点赞数写回 article.like_count、点赞变化写回 article_like，
+// 失败时把取出的变化放回并重新标记为脏
+func (ar *articleRepo) FlushArticleLikes(ctx context.Context, batch int) (int, error) {
+	members, err := ar.data.rdb.SPopN(ctx, likeDirtyKey, int64(batch)).Result()
+	if err != nil {
+		return 0, biz.ErrLikeStoreUnavailable.WithCause(err)
+	}
+	if len(members) == 0 {
+		return 0, nil
+	}
+	changes := make([]*likeChanges, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseInt(m, 10, 64)
+		if err != nil {
+			continue
+		}
+		vals, err := takeLikeScript.Run(ctx, ar.data.rdb, []string{likeKey(id), likePendingKey(id)}).StringSlice()
+		if err != nil {
+			ar.requeueLikes(members, changes)
+			return 0, biz.ErrLikeStoreUnavailable.WithCause(err)
+		}
+		c := &likeChanges{id: id, count: vals[0], users: make(map[int64]bool, (len(vals)-1)/2)}
+		for i := 1; i+1 < len(vals); i += 2 {
+			if user, err := strconv.ParseInt(vals[i], 10, 64); err == nil {
+				c.users[user] = vals[i+1] == "1"
+			}
+		}
+		changes = append(changes, c)
+	}
+
+	now := time.Now()
+	err = ar.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, c := range changes {
+			for user, liked := range c.users {
+				var err error
+				if liked {
+					err = tx.Clauses(clause.OnConflict{DoNothing: true}).
+						Create(&articleLike{ArticleId: c.id, UserId: user, CreatedAt: now}).Error
+				} else {
+					err = tx.Where("article_id = ? AND user_id = ?", c.id, user).Delete(&articleLike{}).Error
+				}
+				if err != nil {
+					return err
+				}
+			}
+			n, err := strconv.ParseInt(c.count, 10, 64)
+			if err != nil {
+				continue // 计数已被删除
+			}
+			// UpdateColumn 不触发 updated_at
+			if err := tx.Model(&article{}).Where("id = ?", c.id).UpdateColumn("like_count", n).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ar.log.Errorf("flush like_count error: %v", err)
+		ar.requeueLikes(members, changes)
+		return 0, err
+	}
+	return len(members), nil
+}
+
+// requeueLikes 回写失败后放回取出的点赞变化，期间产生的新变化优先
+func (ar *articleRepo) requeueLikes(members []string, changes []*likeChanges) {
+	ctx := context.Background()
+	pipe := ar.data.rdb.Pipeline()
+	for _, c := range changes {
+		for user, liked := range c.users {
+			flag := "0"
+			if liked {
+				flag = "1"
+			}
+			pipe.HSetNX(ctx, likePendingKey(c.id), strconv.FormatInt(user, 10), flag)
+		}
+	}
+	args := make([]interface{}, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	pipe.SAdd(ctx, likeDirtyKey, args...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		ar.log.Errorf("flush like_count requeue error: %v", err)
+	}
+}
+
+// FlushArticleViews 取出至多 batch 个脏浏览数写回 article.view_count
+func (ar *articleRepo) FlushArticleViews(ctx context.Context, batch int) (int, error) {
+	return ar.flushCounters(ctx, viewDirtyKey, "view:", "view_count", batch)
+}
+
+// flushCounters 从 dirtyKey 取出至多 batch 个文章 id，把 prefix+id 的计数写回 column，
+// 写库失败时重新标记为脏；目前用于浏览数
+func (ar *articleRepo) flushCounters(ctx context.Context, dirtyKey, prefix, column string, batch int) (int, error) {
+	members, err := ar.data.rdb.SPopN(ctx, dirtyKey, int64(batch)).Result()
+	if err != nil {
+		return 0, biz.ErrViewStoreUnavailable.WithCause(err)
+	}
+	if len(members) == 0 {
+		return 0, nil
+	}
+	requeue := func() {
+		args := make([]interface{}, len(members))
+		for i, m := range members {
+			args[i] = m
+		}
+		if err := ar.data.rdb.SAdd(context.Background(), dirtyKey, args...).Err(); err != nil {
+			ar.log.Errorf("flush %s requeue error: %v", column, err)
+		}
+	}
+
+	keys := make([]string, len(members))
+	for i, m := range members {
+		keys[i] = prefix + m
+	}
+	vals, err := ar.data.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		requeue()
+		return 0, biz.ErrViewStoreUnavailable.WithCause(err)
+	}
+
+	err = ar.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, m := range members {
+			v, ok := vals[i].(string)
+			if !ok {
+				continue // 计数已被删除
+			}
+			id, err1 := strconv.ParseInt(m, 10, 64)
+			n, err2 := strconv.ParseInt(v, 10, 64)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			// UpdateColumn 不触发 updated_at
+			if err := tx.Model(&article{}).Where("id = ?", id).UpdateColumn(column, n).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ar.log.Errorf("flush %s error: %v", column, err)
+		requeue()
+		return 0, err
+	}
+	return len(members), nil
 }
@@ -0,0 +1,138 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// indexLoadBatch 按批从数据库加载已发布文章建立内存索引
+	indexLoadBatch = 500
+	// fulltextIndex search.driver=mysql 使用的 FULLTEXT(ngram) 索引
+	fulltextIndex = "ft_article_title_content"
+)
+
+// NewArticleSearcher picks the search backend named by search.driver.
+func NewArticleSearcher(c *conf.Data, data *Data, logger log.Logger) (biz.ArticleSearcher, error) {
+	switch driver := strings.ToLower(c.GetSearch().GetDriver()); driver {
+	case "", "memory":
+		s := &memorySearcher{memoryIndex: newMemoryIndex(), data: data, log: log.NewHelper(logger)}
+		if err := loadMemoryIndex(context.Background(), data, s.memoryIndex); err != nil {
+			return nil, fmt.Errorf("build search index: %w", err)
+		}
+		s.log.Infof("search index built with %d articles", s.size())
+		return s, nil
+	case "mysql":
+		db, err := driverName(c.Database)
+		if err != nil {
+			return nil, err
+		}
+		if db != "mysql" {
+			return nil, fmt.Errorf("search driver mysql needs the mysql database driver, got %q", db)
+		}
+		s := &mysqlSearcher{data: data, log: log.NewHelper(logger)}
+		if err := s.checkIndex(context.Background()); err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unsupported search driver %q", driver)
+	}
+}
+
+func loadMemoryIndex(ctx context.Context, data *Data, idx *memoryIndex) error {
+	var last int64
+	for {
+		var list []*article
+		err := data.db.WithContext(ctx).
+			Select("id", "title", "content").
+			Where("status = ? AND id > ?", string(biz.ArticleStatusPublished), last).
+			Order("id").Limit(indexLoadBatch).
+			Find(&list).Error
+		if err != nil {
+			return err
+		}
+		for _, a := range list {
+			idx.add(a.Id, a.Title, a.Content)
+			last = a.Id
+		}
+		if len(list) < indexLoadBatch {
+			return nil
+		}
+	}
+}
+
+// mysqlSearcher 使用 article 表上的 FULLTEXT(ngram) 索引，写入时由 mysql 自行维护
+type mysqlSearcher struct {
+	data *Data
+	log  *log.Helper
+}
+
+// checkIndex 索引由迁移 0008 创建，启动时只检查是否存在，不在服务进程里执行 DDL
+func (s *mysqlSearcher) checkIndex(ctx context.Context) error {
+	var n int64
+	err := s.data.db.WithContext(ctx).Table("information_schema.statistics").
+		Where("table_schema = DATABASE() AND table_name = ? AND index_name = ?", "article", fulltextIndex).
+		Count(&n).Error
+	if err != nil {
+		return fmt.Errorf("check fulltext index: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("fulltext index %s is missing on article, run `migrate up` first", fulltextIndex)
+	}
+	return nil
+}
+
+func (s *mysqlSearcher) Index(ctx context.Context, a *biz.Article) error {
+	return nil
+}
+
+// Refresh 索引由 mysql 随写入维护
+func (s *mysqlSearcher) Refresh(ctx context.Context) error {
+	return nil
+}
+
+func (s *mysqlSearcher) Remove(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (s *mysqlSearcher) Search(ctx context.Context, q *biz.SearchQuery) ([]*biz.SearchHit, error) {
+	const match = "MATCH(title, content) AGAINST (? IN NATURAL LANGUAGE MODE)"
+	var hits []*biz.SearchHit
+	err := s.data.db.WithContext(ctx).Model(&article{}).
+		Select("id, "+match+" AS score", q.Query).
+		Where("status = ? AND "+match, string(biz.ArticleStatusPublished), q.Query).
+		Order("score DESC, id DESC").
+		Offset(q.Offset).Limit(q.Limit).
+		Scan(&hits).Error
+	if err != nil {
+		s.log.Errorf("Search error: %v", err)
+		return nil, err
+	}
+	return hits, nil
+}
+
+// memorySearcher 进程内索引，本副本的写入立即生效，其他副本的写入靠 Refresh 定期重建同步
+type memorySearcher struct {
+	*memoryIndex
+	data *Data
+	log  *log.Helper
+}
+
+// Refresh 从数据库重建索引后整体替换，重建期间本副本的写入会保留下来
+func (s *memorySearcher) Refresh(ctx context.Context) error {
+	s.beginRebuild()
+	fresh := newMemoryIndex()
+	if err := loadMemoryIndex(ctx, s.data, fresh); err != nil {
+		s.abortRebuild()
+		s.log.Errorf("Refresh search index error: %v", err)
+		return err
+	}
+	s.swap(fresh)
+	return nil
+}
@@ -0,0 +1,193 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"math"
+	"sort"
+	"sync"
+)
+
+// BM25 参数，标题中的词按 titleWeight 倍计入词频
+const (
+	bm25K1      = 1.2
+	bm25B       = 0.75
+	titleWeight = 2
+)
+
+// memoryIndex 进程内倒排索引，其他副本的写入不会同步到这里，由 memorySearcher 定期重建
+type memoryIndex struct {
+	mu       sync.RWMutex
+	postings map[string]map[int64]int // term -> article id -> weighted term frequency
+	docs     map[int64]indexedDoc
+	totalLen int
+	// changed 重建期间本地写入过的文章，替换时以当前索引中的状态为准；不在重建时为 nil
+	changed map[int64]struct{}
+}
+
+type indexedDoc struct {
+	length int
+	terms  []string
+}
+
+func newMemoryIndex() *memoryIndex {
+	return &memoryIndex{
+		postings: make(map[string]map[int64]int),
+		docs:     make(map[int64]indexedDoc),
+	}
+}
+
+func (m *memoryIndex) size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.docs)
+}
+
+func (m *memoryIndex) add(id int64, title, content string) {
+	tf := make(map[string]int)
+	for _, t := range biz.SearchTokens(title) {
+		tf[t] += titleWeight
+	}
+	for _, t := range biz.SearchTokens(content) {
+		tf[t]++
+	}
+	doc := indexedDoc{terms: make([]string, 0, len(tf))}
+	for t, n := range tf {
+		doc.length += n
+		doc.terms = append(doc.terms, t)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.touch(id)
+	m.remove(id)
+	for t, n := range tf {
+		p := m.postings[t]
+		if p == nil {
+			p = make(map[int64]int)
+			m.postings[t] = p
+		}
+		p[id] = n
+	}
+	m.docs[id] = doc
+	m.totalLen += doc.length
+}
+
+// remove 调用方需持有写锁
+func (m *memoryIndex) remove(id int64) {
+	doc, ok := m.docs[id]
+	if !ok {
+		return
+	}
+	for _, t := range doc.terms {
+		delete(m.postings[t], id)
+		if len(m.postings[t]) == 0 {
+			delete(m.postings, t)
+		}
+	}
+	delete(m.docs, id)
+	m.totalLen -= doc.length
+}
+
+func (m *memoryIndex) Index(ctx context.Context, a *biz.Article) error {
+	m.add(a.Id, a.Title, a.Content)
+	return nil
+}
+
+func (m *memoryIndex) Remove(ctx context.Context, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.touch(id)
+	m.remove(id)
+	return nil
+}
+
+// touch 调用方需持有写锁
+func (m *memoryIndex) touch(id int64) {
+	if m.changed != nil {
+		m.changed[id] = struct{}{}
+	}
+}
+
+// beginRebuild 开始记录本地写入，之后必须调用 swap 或 abortRebuild
+func (m *memoryIndex) beginRebuild() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changed = make(map[int64]struct{})
+}
+
+func (m *memoryIndex) abortRebuild() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changed = nil
+}
+
+// swap 用重建好的 fresh 替换当前内容；重建期间本地写入过的文章可能晚于 fresh 读到的数据，沿用当前索引里的状态
+func (m *memoryIndex) swap(fresh *memoryIndex) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id := range m.changed {
+		fresh.remove(id)
+		doc, ok := m.docs[id]
+		if !ok {
+			continue
+		}
+		for _, t := range doc.terms {
+			p := fresh.postings[t]
+			if p == nil {
+				p = make(map[int64]int)
+				fresh.postings[t] = p
+			}
+			p[id] = m.postings[t][id]
+		}
+		fresh.docs[id] = doc
+		fresh.totalLen += doc.length
+	}
+	m.postings, m.docs, m.totalLen = fresh.postings, fresh.docs, fresh.totalLen
+	m.changed = nil
+}
+
+// Search 按 BM25 打分，命中任一查询词即可入选
+func (m *memoryIndex) Search(ctx context.Context, q *biz.SearchQuery) ([]*biz.SearchHit, error) {
+	m.mu.RLock()
+	n := float64(len(m.docs))
+	if n == 0 {
+		m.mu.RUnlock()
+		return nil, nil
+	}
+	avgLen := float64(m.totalLen) / n
+	scores := make(map[int64]float64)
+	for _, t := range q.Terms {
+		p := m.postings[t]
+		if len(p) == 0 {
+			continue
+		}
+		df := float64(len(p))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, tf := range p {
+			f := float64(tf)
+			dl := float64(m.docs[id].length)
+			scores[id] += idf * f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*dl/avgLen))
+		}
+	}
+	m.mu.RUnlock()
+
+	hits := make([]*biz.SearchHit, 0, len(scores))
+	for id, s := range scores {
+		hits = append(hits, &biz.SearchHit{Id: id, Score: s})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Id > hits[j].Id
+	})
+	if q.Offset >= len(hits) {
+		return nil, nil
+	}
+	hits = hits[q.Offset:]
+	if len(hits) > q.Limit {
+		hits = hits[:q.Limit]
+	}
+	return hits, nil
+}
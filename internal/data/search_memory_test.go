@@ -0,0 +1,167 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func searchIds(t *testing.T, s interface {
+	Search(context.Context, *biz.SearchQuery) ([]*biz.SearchHit, error)
+}, query string, offset, limit int) []int64 {
+	t.Helper()
+	hits, err := s.Search(context.Background(), &biz.SearchQuery{Query: query, Terms: biz.SearchTokens(query), Offset: offset, Limit: limit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]int64, 0, len(hits))
+	for _, h := range hits {
+		if h.Score <= 0 {
+			t.Fatalf("hit %d has score %v", h.Id, h.Score)
+		}
+		ids = append(ids, h.Id)
+	}
+	return ids
+}
+
+func equalIds(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMemoryIndexBM25(t *testing.T) {
+	tests := []struct {
+		name  string
+		docs  map[int64][2]string // id -> title, content
+		query string
+		want  []int64
+	}{
+		{"title counts more", map[int64][2]string{1: {"x", "go"}, 2: {"go", "x"}}, "go", []int64{2, 1}},
+		{"shorter document first", map[int64][2]string{1: {"", "go a b c d e f"}, 2: {"", "go"}}, "go", []int64{2, 1}},
+		{"higher frequency first", map[int64][2]string{1: {"", "go a"}, 2: {"", "go go"}}, "go", []int64{2, 1}},
+		{"rare term weighs more", map[int64][2]string{1: {"", "go x"}, 2: {"", "go y"}, 3: {"", "rust x"}, 4: {"", "z x"}}, "go rust", []int64{3, 2, 1}},
+		{"ties by id desc", map[int64][2]string{1: {"", "go"}, 2: {"", "go"}}, "go", []int64{2, 1}},
+		{"non matching left out", map[int64][2]string{1: {"", "rust"}, 2: {"", "go"}}, "go", []int64{2}},
+		{"chinese", map[int64][2]string{1: {"", "世界和平"}, 2: {"你好世界", ""}}, "世界", []int64{2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx := newMemoryIndex()
+			for id, d := range tt.docs {
+				idx.add(id, d[0], d[1])
+			}
+			if got := searchIds(t, idx, tt.query, 0, 10); !equalIds(got, tt.want) {
+				t.Fatalf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryIndexPagingAndRemove(t *testing.T) {
+	idx := newMemoryIndex()
+	for id := int64(1); id <= 5; id++ {
+		idx.add(id, "", "go")
+	}
+	if got := searchIds(t, idx, "go", 1, 2); !equalIds(got, []int64{4, 3}) {
+		t.Fatalf("page = %v", got)
+	}
+	if got := searchIds(t, idx, "go", 5, 2); len(got) != 0 {
+		t.Fatalf("page past the end = %v", got)
+	}
+
+	// 重复写入替换旧内容
+	idx.add(3, "", "rust")
+	if err := idx.Remove(context.Background(), 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := idx.Remove(context.Background(), 42); err != nil {
+		t.Fatal(err)
+	}
+	if got := searchIds(t, idx, "go", 0, 10); !equalIds(got, []int64{4, 2, 1}) {
+		t.Fatalf("after update = %v", got)
+	}
+	if idx.size() != 4 || idx.totalLen != 4 {
+		t.Fatalf("size %d, totalLen %d, want 4 and 4", idx.size(), idx.totalLen)
+	}
+	if _, ok := idx.postings["go"][3]; ok {
+		t.Fatal("stale posting left behind")
+	}
+}
+
+func TestMemoryIndexSwapKeepsLocalWrites(t *testing.T) {
+	idx := newMemoryIndex()
+	idx.add(1, "", "old")
+	idx.add(2, "", "gone")
+
+	idx.beginRebuild()
+	// fresh 读到的是写入前的数据
+	fresh := newMemoryIndex()
+	fresh.add(1, "", "old")
+	fresh.add(2, "", "gone")
+	fresh.add(3, "", "remote")
+	idx.add(1, "", "new")
+	if err := idx.Remove(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+	idx.swap(fresh)
+
+	if got := searchIds(t, idx, "new", 0, 10); !equalIds(got, []int64{1}) {
+		t.Fatalf("local update lost: %v", got)
+	}
+	if got := searchIds(t, idx, "old gone", 0, 10); len(got) != 0 {
+		t.Fatalf("stale documents back: %v", got)
+	}
+	if got := searchIds(t, idx, "remote", 0, 10); !equalIds(got, []int64{3}) {
+		t.Fatalf("rebuilt document missing: %v", got)
+	}
+	if idx.changed != nil || idx.totalLen != 2 {
+		t.Fatalf("changed %v, totalLen %d", idx.changed, idx.totalLen)
+	}
+}
+
+func TestMemorySearcherRefresh(t *testing.T) {
+	d, c, _ := newTestData(t)
+	local, err := NewArticleSearcher(c, d, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 另一个副本的索引
+	remote, err := NewArticleSearcher(c, d, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc := biz.NewArticleUsecase(testAuth(), NewArticleRepo(c, d, log.DefaultLogger), NewLocker(d, log.DefaultLogger), local, log.DefaultLogger)
+	ids := createPublished(t, uc, 2)
+
+	if got := searchIds(t, local, "title", 0, 10); len(got) != 2 {
+		t.Fatalf("local index = %v", got)
+	}
+	if got := searchIds(t, remote, "title", 0, 10); len(got) != 0 {
+		t.Fatalf("remote index before refresh = %v", got)
+	}
+	if err = remote.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := searchIds(t, remote, "title", 0, 10); !equalIds(got, []int64{ids[1], ids[0]}) {
+		t.Fatalf("remote index after refresh = %v", got)
+	}
+
+	if err = uc.Delete(authorContext(1), ids[0], false); err != nil {
+		t.Fatal(err)
+	}
+	if err = remote.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := searchIds(t, remote, "title", 0, 10); !equalIds(got, []int64{ids[1]}) {
+		t.Fatalf("trashed article still indexed: %v", got)
+	}
+}
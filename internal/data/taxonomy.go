@@ -0,0 +1,334 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tag struct {
+	Id        int64     `gorm:"primaryKey"`
+	Name      string    `gorm:"size:32"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (tag) TableName() string {
+	return "tag"
+}
+
+// articleTag 文章与标签的多对多关联
+type articleTag struct {
+	ArticleId int64 `gorm:"primaryKey;autoIncrement:false"`
+	TagId     int64 `gorm:"primaryKey;autoIncrement:false"`
+}
+
+func (articleTag) TableName() string {
+	return "article_tag"
+}
+
+type category struct {
+	Id          int64     `gorm:"primaryKey"`
+	Name        string    `gorm:"size:50"`
+	Description string    `gorm:"size:200"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+func (category) TableName() string {
+	return "category"
+}
+
+// loadTags 一次查询填充多篇文章的标签
+func loadTags(db *gorm.DB, list ...*article) error {
+	if len(list) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(list))
+	byId := make(map[int64]*article, len(list))
+	for _, a := range list {
+		ids = append(ids, a.Id)
+		byId[a.Id] = a
+	}
+	var rows []struct {
+		ArticleId int64
+		Name      string
+	}
+	err := db.Table("article_tag").
+		Select("article_tag.article_id, tag.name").
+		Joins("JOIN tag ON tag.id = article_tag.tag_id").
+		Where("article_tag.article_id IN ?", ids).
+		Order("tag.name").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		a := byId[row.ArticleId]
+		a.Tags = append(a.Tags, row.Name)
+	}
+	return nil
+}
+
+// setArticleTags 在事务内替换文章的全部标签，不存在的标签按名字创建
+func setArticleTags(tx *gorm.DB, articleId int64, names []string) error {
+	if err := tx.Where("article_id = ?", articleId).Delete(&articleTag{}).Error; err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	now := time.Now()
+	tags := make([]*tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, &tag{Name: name, CreatedAt: now})
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		return err
+	}
+	// 冲突的行拿不到 id，重新按名字查询
+	var ids []int64
+	if err := tx.Model(&tag{}).Where("name IN ?", names).Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+	rows := make([]*articleTag, 0, len(ids))
+	for _, id := range ids {
+		rows = append(rows, &articleTag{ArticleId: articleId, TagId: id})
+	}
+	return tx.Create(&rows).Error
+}
+
+func checkCategory(tx *gorm.DB, id int64) error {
+	if id == 0 {
+		return nil
+	}
+	var n int64
+	if err := tx.Model(&category{}).Where("id = ?", id).Count(&n).Error; err != nil {
+		return err
+	}
+	if n == 0 {
+		return biz.ErrCategoryNotFound
+	}
+	return nil
+}
+
+type taxonomyRepo struct {
+	data  *Data
+	cache *articleCache
+	log   *log.Helper
+}
+
+func NewTaxonomyRepo(c *conf.Data, data *Data, logger log.Logger) biz.TaxonomyRepo {
+	return &taxonomyRepo{
+		data:  data,
+		cache: data.articles,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// publishedArticle 计数只算已发布且未删除的文章
+const publishedArticle = "article.deleted_at IS NULL AND article.status = 'published'"
+
+// evict 标签或分类变化后清除受影响文章的缓存
+func (r *taxonomyRepo) evict(ctx context.Context, ids []int64) {
+	for _, id := range ids {
+		r.cache.del(ctx, id)
+	}
+}
+
+func (r *taxonomyRepo) CreateTag(ctx context.Context, t *biz.Tag) error {
+	model := &tag{Name: t.Name}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicate(err, ukTagName) {
+			return biz.ErrTagDuplicate
+		}
+		r.log.Errorf("CreateTag error: %v", err)
+		return err
+	}
+	t.Id = model.Id
+	t.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *taxonomyRepo) UpdateTag(ctx context.Context, t *biz.Tag) error {
+	var ids []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&tag{}).Where("id = ?", t.Id).Update("name", t.Name)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var n int64
+			if err := tx.Model(&tag{}).Where("id = ?", t.Id).Count(&n).Error; err != nil {
+				return err
+			}
+			if n == 0 {
+				return biz.ErrTagNotFound
+			}
+		}
+		return tx.Model(&articleTag{}).Where("tag_id = ?", t.Id).Pluck("article_id", &ids).Error
+	})
+	if err != nil {
+		if isDuplicate(err, ukTagName) {
+			return biz.ErrTagDuplicate
+		}
+		if !biz.ErrTagNotFound.Is(err) {
+			r.log.Errorf("UpdateTag error: %v", err)
+		}
+		return err
+	}
+	r.evict(ctx, ids)
+	return nil
+}
+
+func (r *taxonomyRepo) DeleteTag(ctx context.Context, id int64) error {
+	var ids []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&tag{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrTagNotFound
+		}
+		if err := tx.Model(&articleTag{}).Where("tag_id = ?", id).Pluck("article_id", &ids).Error; err != nil {
+			return err
+		}
+		return tx.Where("tag_id = ?", id).Delete(&articleTag{}).Error
+	})
+	if err != nil {
+		if !biz.ErrTagNotFound.Is(err) {
+			r.log.Errorf("DeleteTag error: %v", err)
+		}
+		return err
+	}
+	r.evict(ctx, ids)
+	return nil
+}
+
+func (r *taxonomyRepo) ListTags(ctx context.Context) ([]*biz.Tag, error) {
+	var rows []struct {
+		Id           int64
+		Name         string
+		CreatedAt    time.Time
+		ArticleCount int64
+	}
+	err := r.data.db.WithContext(ctx).Model(&tag{}).
+		Select("tag.id, tag.name, tag.created_at, COUNT(article.id) AS article_count").
+		Joins("LEFT JOIN article_tag ON article_tag.tag_id = tag.id").
+		Joins("LEFT JOIN article ON article.id = article_tag.article_id AND " + publishedArticle).
+		Group("tag.id, tag.name, tag.created_at").
+		Order("tag.name").
+		Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("ListTags error: %v", err)
+		return nil, err
+	}
+	result := make([]*biz.Tag, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &biz.Tag{
+			Id:           row.Id,
+			Name:         row.Name,
+			ArticleCount: row.ArticleCount,
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+func (r *taxonomyRepo) CreateCategory(ctx context.Context, c *biz.Category) error {
+	model := &category{Name: c.Name, Description: c.Description}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicate(err, ukCategoryName) {
+			return biz.ErrCategoryDuplicate
+		}
+		r.log.Errorf("CreateCategory error: %v", err)
+		return err
+	}
+	c.Id = model.Id
+	c.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (r *taxonomyRepo) UpdateCategory(ctx context.Context, c *biz.Category) error {
+	result := r.data.db.WithContext(ctx).Model(&category{}).Where("id = ?", c.Id).
+		Updates(map[string]interface{}{"name": c.Name, "description": c.Description})
+	if result.Error != nil {
+		if isDuplicate(result.Error, ukCategoryName) {
+			return biz.ErrCategoryDuplicate
+		}
+		r.log.Errorf("UpdateCategory error: %v", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		var n int64
+		if err := r.data.db.WithContext(ctx).Model(&category{}).Where("id = ?", c.Id).Count(&n).Error; err != nil {
+			return err
+		}
+		if n == 0 {
+			return biz.ErrCategoryNotFound
+		}
+	}
+	return nil
+}
+
+func (r *taxonomyRepo) DeleteCategory(ctx context.Context, id int64) error {
+	var ids []int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&category{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrCategoryNotFound
+		}
+		// 回收站里的文章也一并解除分类
+		if err := tx.Unscoped().Model(&article{}).Where("category_id = ?", id).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Model(&article{}).Where("category_id = ?", id).
+			UpdateColumn("category_id", nil).Error
+	})
+	if err != nil {
+		if !biz.ErrCategoryNotFound.Is(err) {
+			r.log.Errorf("DeleteCategory error: %v", err)
+		}
+		return err
+	}
+	r.evict(ctx, ids)
+	return nil
+}
+
+func (r *taxonomyRepo) ListCategories(ctx context.Context) ([]*biz.Category, error) {
+	var rows []struct {
+		Id           int64
+		Name         string
+		Description  string
+		CreatedAt    time.Time
+		ArticleCount int64
+	}
+	err := r.data.db.WithContext(ctx).Model(&category{}).
+		Select("category.id, category.name, category.description, category.created_at, COUNT(article.id) AS article_count").
+		Joins("LEFT JOIN article ON article.category_id = category.id AND " + publishedArticle).
+		Group("category.id, category.name, category.description, category.created_at").
+		Order("category.name").
+		Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("ListCategories error: %v", err)
+		return nil, err
+	}
+	result := make([]*biz.Category, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &biz.Category{
+			Id:           row.Id,
+			Name:         row.Name,
+			Description:  row.Description,
+			ArticleCount: row.ArticleCount,
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+	return result, nil
+}
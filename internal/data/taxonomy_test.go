@@ -0,0 +1,74 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestListArticleByTag(t *testing.T) {
+	uc, _ := newTestArticleUsecase(t)
+	ctx := authorContext(1)
+	for _, a := range []*biz.Article{
+		{Title: "a", Content: "c", Tags: []string{"go", "db"}},
+		{Title: "b", Content: "c", Tags: []string{"db"}},
+		{Title: "c", Content: "c"},
+	} {
+		if err := uc.Create(ctx, a); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := uc.Publish(ctx, a.Id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for tag, want := range map[string]int{"go": 1, "db": 2, "none": 0} {
+		page, err := uc.List(ctx, &biz.ListArticleQuery{ArticleFilter: biz.ArticleFilter{Tag: tag}, PageSize: 10, WithTotal: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Articles) != want || page.Total != int64(want) {
+			t.Fatalf("tag %q: got %d articles, total %d, want %d", tag, len(page.Articles), page.Total, want)
+		}
+	}
+}
+
+func TestTaxonomyRepoSharesArticleCache(t *testing.T) {
+	d, c, mr := newTestData(t)
+	articles := NewArticleRepo(c, d, log.DefaultLogger).(*articleRepo)
+	taxonomy := NewTaxonomyRepo(c, d, log.DefaultLogger).(*taxonomyRepo)
+	if articles.cache != taxonomy.cache {
+		t.Fatal("taxonomy repo should share the article cache")
+	}
+
+	ctx := context.Background()
+	a := &biz.Article{Title: "t", Content: "c", Tags: []string{"go"}}
+	if err := articles.CreateArticle(ctx, a, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := articles.GetArticle(ctx, a.Id); err != nil {
+		t.Fatal(err)
+	}
+	if !mr.Exists(articleCacheKey(a.Id)) {
+		t.Fatal("article should be cached after get")
+	}
+
+	tags, err := taxonomy.ListTags(ctx)
+	if err != nil || len(tags) != 1 {
+		t.Fatalf("ListTags: %v, %v", tags, err)
+	}
+	if err := taxonomy.UpdateTag(ctx, &biz.Tag{Id: tags[0].Id, Name: "golang"}); err != nil {
+		t.Fatal(err)
+	}
+	if mr.Exists(articleCacheKey(a.Id)) {
+		t.Fatal("renaming a tag should evict the articles carrying it")
+	}
+	got, err := articles.GetArticle(ctx, a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Tags) != 1 || got.Tags[0] != "golang" {
+		t.Fatalf("tags after rename = %v", got.Tags)
+	}
+}
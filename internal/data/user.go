@@ -0,0 +1,179 @@
+package data
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// user 表名用复数，user 在 postgres 中是保留字
+type user struct {
+	Id           int64     `gorm:"primaryKey"`
+	Username     string    `gorm:"size:32"`
+	PasswordHash string    `gorm:"size:255"`
+	DisplayName  string    `gorm:"size:64"`
+	Bio          string    `gorm:"size:500"`
+	Role         string    `gorm:"size:32"`
+	CreatedAt    time.Time `gorm:"column:created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at"`
+}
+
+func (user) TableName() string {
+	return "users"
+}
+
+func (u *user) toDomain() *biz.User {
+	return &biz.User{
+		Id:           u.Id,
+		Username:     u.Username,
+		PasswordHash: u.PasswordHash,
+		DisplayName:  u.DisplayName,
+		Bio:          u.Bio,
+		Role:         u.Role,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+	}
+}
+
+// articleAuthor 文章回包里嵌入的作者信息，随文章模型一起缓存
+type articleAuthor struct {
+	Id          int64
+	Username    string
+	DisplayName string
+}
+
+// loadAuthors 一次查询填充多篇文章的作者
+func loadAuthors(db *gorm.DB, list ...*article) error {
+	ids := make([]int64, 0, len(list))
+	for _, a := range list {
+		if a.AuthorId > 0 {
+			ids = append(ids, a.AuthorId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	var rows []*articleAuthor
+	err := db.Model(&user{}).
+		Select("id, username, display_name").
+		Where("id IN ?", ids).
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+	byId := make(map[int64]*articleAuthor, len(rows))
+	for _, row := range rows {
+		byId[row.Id] = row
+	}
+	for _, a := range list {
+		a.Author = byId[a.AuthorId]
+	}
+	return nil
+}
+
+type userRepo struct {
+	data  *Data
+	cache *articleCache
+	log   *log.Helper
+}
+
+func NewUserRepo(c *conf.Data, data *Data, logger log.Logger) biz.UserRepo {
+	return &userRepo{
+		data:  data,
+		cache: data.articles,
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (r *userRepo) CreateUser(ctx context.Context, u *biz.User) error {
+	model := &user{
+		Username:     u.Username,
+		PasswordHash: u.PasswordHash,
+		DisplayName:  u.DisplayName,
+		Bio:          u.Bio,
+		Role:         u.Role,
+	}
+	if err := r.data.db.WithContext(ctx).Create(model).Error; err != nil {
+		if isDuplicate(err, ukUsersUsername) {
+			return biz.ErrUsernameTaken
+		}
+		r.log.Errorf("CreateUser error: %v", err)
+		return err
+	}
+	u.Id = model.Id
+	u.CreatedAt = model.CreatedAt
+	u.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+func (r *userRepo) get(ctx context.Context, query string, arg interface{}) (*biz.User, error) {
+	var model user
+	if err := r.data.db.WithContext(ctx).Where(query, arg).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrAccountNotFound
+		}
+		r.log.Errorf("GetUser error: %v", err)
+		return nil, err
+	}
+	return model.toDomain(), nil
+}
+
+func (r *userRepo) GetUser(ctx context.Context, id int64) (*biz.User, error) {
+	return r.get(ctx, "id = ?", id)
+}
+
+func (r *userRepo) GetUserByUsername(ctx context.Context, username string) (*biz.User, error) {
+	return r.get(ctx, "username = ?", username)
+}
+
+func (r *userRepo) UpdateUser(ctx context.Context, u *biz.User, fields []string) error {
+	values := map[string]interface{}{"updated_at": time.Now()}
+	var evict bool
+	for _, f := range fields {
+		switch f {
+		case biz.UserFieldDisplayName:
+			values["display_name"] = u.DisplayName
+			evict = true
+		case biz.UserFieldBio:
+			values["bio"] = u.Bio
+		case biz.UserFieldPassword:
+			values["password_hash"] = u.PasswordHash
+		case biz.UserFieldRole:
+			values["role"] = u.Role
+		default:
+			return fmt.Errorf("unknown user field %q", f)
+		}
+	}
+	result := r.data.db.WithContext(ctx).Model(&user{}).Where("id = ?", u.Id).Updates(values)
+	if result.Error != nil {
+		r.log.Errorf("UpdateUser error: %v", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return biz.ErrAccountNotFound
+	}
+	if evict {
+		r.evictArticles(ctx, u.Id)
+	}
+	return nil
+}
+
+// evictArticles 显示名变化后清除该作者文章的缓存
+func (r *userRepo) evictArticles(ctx context.Context, authorId int64) {
+	var ids []int64
+	err := r.data.db.WithContext(ctx).Unscoped().Model(&article{}).
+		Where("author_id = ?", authorId).Pluck("id", &ids).Error
+	if err != nil {
+		r.log.Errorf("list articles of author %d error: %v", authorId, err)
+		return
+	}
+	for _, id := range ids {
+		r.cache.del(ctx, id)
+	}
+}
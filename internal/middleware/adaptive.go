@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"agdemo/internal/conf"
+	myRatelimit "agdemo/internal/middleware/ratelimit"
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// healthOperations 负载再高也要回答健康检查，否则探针失败会让编排系统重启或摘除过载的实例
+var healthOperations = []string{"/grpc.health.v1.Health/*"}
+
+// Adaptive 按服务负载丢弃请求，两个 server 共用同一个限流器
+type Adaptive struct {
+	limiter myRatelimit.AdaptiveLimiter
+}
+
+func NewAdaptive(c *conf.Server) (*Adaptive, error) {
+	ac := c.GetAdaptive()
+	var l myRatelimit.AdaptiveLimiter
+	switch ac.GetAlgorithm() {
+	case "":
+		return &Adaptive{}, nil
+	case myRatelimit.AlgorithmBBR:
+		l = myRatelimit.NewBBRLimiter(int64(ac.GetCpuThreshold()), ac.GetWindow().AsDuration(), int(ac.GetBuckets()))
+	case myRatelimit.AlgorithmAIMD:
+		l = myRatelimit.NewAIMDLimiter(myRatelimit.AIMDConfig{
+			InitialLimit:     int(ac.GetInitialLimit()),
+			MinLimit:         int(ac.GetMinLimit()),
+			MaxLimit:         int(ac.GetMaxLimit()),
+			LatencyThreshold: ac.GetLatencyThreshold().AsDuration(),
+			BackoffRatio:     ac.GetBackoffRatio(),
+		})
+	default:
+		return nil, fmt.Errorf("adaptive: unknown algorithm %q", ac.GetAlgorithm())
+	}
+	l, err := myRatelimit.Instrument(ac.GetAlgorithm(), l)
+	if err != nil {
+		return nil, fmt.Errorf("adaptive: %w", err)
+	}
+	return &Adaptive{limiter: l}, nil
+}
+
+// Server 未配置算法时不做任何处理；健康检查不计入并发也不会被丢弃
+func (a *Adaptive) Server() middleware.Middleware {
+	if a.limiter == nil {
+		return func(handler middleware.Handler) middleware.Handler {
+			return handler
+		}
+	}
+	limit := ratelimit.Server(ratelimit.WithLimiter(a.limiter))
+	return func(handler middleware.Handler) middleware.Handler {
+		limited := limit(handler)
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok && matchOperation(healthOperations, tr.Operation()) {
+				return handler(ctx, req)
+			}
+			return limited(ctx, req)
+		}
+	}
+}
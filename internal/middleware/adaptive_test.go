@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"agdemo/internal/conf"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
+)
+
+func TestAdaptiveExemptsHealth(t *testing.T) {
+	a, err := NewAdaptive(&conf.Server{Adaptive: &conf.Server_Adaptive{Algorithm: "aimd", InitialLimit: 1, MinLimit: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 第一个请求一直占着唯一的并发名额
+	block, release := make(chan struct{}), make(chan struct{})
+	h := a.Server()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		if req != nil {
+			close(block)
+			<-release
+		}
+		return nil, nil
+	})
+	go h(serverContext(v1.OperationBlogServiceGetArticle), true)
+	<-block
+	defer close(release)
+
+	if _, err := h(serverContext(v1.OperationBlogServiceListArticle), nil); err != ratelimit.ErrLimitExceed {
+		t.Fatalf("api request err = %v, want limit exceeded", err)
+	}
+	for _, op := range []string{"/grpc.health.v1.Health/Check", "/grpc.health.v1.Health/Watch"} {
+		if _, err := h(serverContext(op), nil); err != nil {
+			t.Fatalf("%s shed: %v", op, err)
+		}
+	}
+}
+
+func TestAdaptiveDisabled(t *testing.T) {
+	a, err := NewAdaptive(&conf.Server{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := a.Server()(callerHandler)
+	for i := 0; i < 100; i++ {
+		if _, err := h(serverContext(v1.OperationBlogServiceListArticle), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := NewAdaptive(&conf.Server{Adaptive: &conf.Server_Adaptive{Algorithm: "vegas"}}); err == nil {
+		t.Fatal("unknown algorithm accepted")
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"agdemo/internal/biz"
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// apiKeyHeader HTTP 头与 gRPC metadata 同名
+const apiKeyHeader = "x-api-key"
+
+// APIKey 认证携带 x-api-key 的请求，并按密钥的 scopes 校验操作；未携带时交给 JWT
+type APIKey struct {
+	keys *biz.ApiKeyUsecase
+}
+
+func NewAPIKey(keys *biz.ApiKeyUsecase) *APIKey {
+	return &APIKey{keys: keys}
+}
+
+// Server 需放在 JWT 之前
+func (a *APIKey) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			key := tr.RequestHeader().Get(apiKeyHeader)
+			if key == "" {
+				return handler(ctx, req)
+			}
+			k, err := a.keys.Authenticate(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			if !matchOperation(k.Scopes, tr.Operation()) {
+				return nil, v1.ErrorPermissionDenied("api key %s is not scoped for %s", k.Prefix, tr.Operation())
+			}
+			return handler(biz.NewCallerContext(ctx, &biz.Caller{
+				UserId:   k.CreatedBy,
+				Username: "apikey:" + strconv.FormatInt(k.Id, 10),
+				ApiKeyId: k.Id,
+				Scopes:   k.Scopes,
+			}), req)
+		}
+	}
+}
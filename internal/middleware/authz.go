@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// OwnerFunc returns the id of the user owning the resource a request targets.
+type OwnerFunc func(ctx context.Context, req interface{}) (int64, error)
+
+// RoleFunc returns the current role of a user.
+type RoleFunc func(ctx context.Context, userId int64) (string, error)
+
+type rolePolicy struct {
+	operations    []string
+	ownOperations []string
+}
+
+// Authz 按调用方角色校验操作权限，own_operations 额外要求调用方是资源的所有者；
+// 角色每次请求从用户表重新读取，令牌里的角色只是签发时的快照
+type Authz struct {
+	roles  map[string]*rolePolicy // 为空表示未配置，放行所有调用方
+	public []string
+	owners map[string]OwnerFunc
+	roleOf RoleFunc
+}
+
+func NewAuthz(c *conf.Auth, article *biz.ArticleUsecase, user *biz.UserUsecase, logger log.Logger) (*Authz, error) {
+	a := &Authz{
+		roles:  make(map[string]*rolePolicy),
+		public: c.GetJwt().GetPublicOperations(),
+		owners: articleOwners(article),
+		roleOf: user.RoleOf,
+	}
+	for _, r := range c.GetAuthz().GetRoles() {
+		if r.Name == "" {
+			return nil, fmt.Errorf("authz role without name")
+		}
+		if _, ok := a.roles[r.Name]; ok {
+			return nil, fmt.Errorf("authz role %q defined twice", r.Name)
+		}
+		for _, op := range r.OwnOperations {
+			if _, ok := a.owners[op]; !ok {
+				return nil, fmt.Errorf("authz role %q: ownership of %s can't be checked", r.Name, op)
+			}
+		}
+		a.roles[r.Name] = &rolePolicy{operations: r.Operations, ownOperations: r.OwnOperations}
+	}
+	if len(a.roles) == 0 {
+		log.NewHelper(logger).Warn("authz has no roles, every authenticated caller may call every operation")
+	}
+	return a, nil
+}
+
+// articleOwners 以文章 id 为目标的操作，所有者是文章作者
+func articleOwners(article *biz.ArticleUsecase) map[string]OwnerFunc {
+	owner := func(ctx context.Context, req interface{}) (int64, error) {
+		r, ok := req.(interface{ GetId() int64 })
+		if !ok {
+			return 0, fmt.Errorf("request %T has no article id", req)
+		}
+		return article.AuthorOf(ctx, r.GetId())
+	}
+	owners := make(map[string]OwnerFunc)
+	for _, op := range []string{
+		v1.OperationBlogServiceUpdateArticle,
+		v1.OperationBlogServiceDeleteArticle,
+		v1.OperationBlogServiceRestoreArticle,
+		v1.OperationBlogServiceSubmitArticle,
+		v1.OperationBlogServiceScheduleArticle,
+		v1.OperationBlogServicePublishArticle,
+		v1.OperationBlogServiceUnpublishArticle,
+		v1.OperationBlogServiceArchiveArticle,
+		v1.OperationBlogServiceListArticleRevisions,
+		v1.OperationBlogServiceGetArticleRevision,
+		v1.OperationBlogServiceDiffArticleRevisions,
+		v1.OperationBlogServiceRollbackArticle,
+	} {
+		owners[op] = owner
+	}
+	return owners
+}
+
+// authorize 返回 nil 表示放行
+func (a *Authz) authorize(ctx context.Context, operation string, req interface{}) error {
+	if matchOperation(a.public, operation) {
+		return nil
+	}
+	caller, ok := biz.CallerFromContext(ctx)
+	if !ok {
+		return biz.ErrUnauthenticated
+	}
+	// api key 的 scopes 已由 APIKey 校验，这里再按创建者的当前角色校验，两者取交集
+	role, ok := a.roles[caller.Role]
+	if !ok {
+		return v1.ErrorPermissionDenied("unknown role %q", caller.Role)
+	}
+	if matchOperation(role.operations, operation) {
+		return nil
+	}
+	if !slices.Contains(role.ownOperations, operation) {
+		return v1.ErrorPermissionDenied("role %s may not call %s", caller.Role, operation)
+	}
+	// 资源不存在和不属于调用方返回同样的错误，不暴露资源是否存在
+	owner, err := a.owners[operation](ctx, req)
+	if err != nil && !biz.ErrArticleNotFound.Is(err) {
+		return err
+	}
+	if err != nil || owner != caller.UserId {
+		return v1.ErrorPermissionDenied("role %s may only call %s on its own articles", caller.Role, operation)
+	}
+	return nil
+}
+
+// currentRole 用用户表中的当前角色替换令牌中的角色，api key 调用方取创建者的角色，写回 context 供后续使用
+func (a *Authz) currentRole(ctx context.Context) (context.Context, error) {
+	caller, ok := biz.CallerFromContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	role, err := a.roleOf(ctx, caller.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if role == caller.Role {
+		return ctx, nil
+	}
+	c := *caller
+	c.Role = role
+	return biz.NewCallerContext(ctx, &c), nil
+}
+
+// Server 需放在 JWT 之后，依赖其写入 context 的调用方
+func (a *Authz) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if len(a.roles) == 0 {
+				return handler(ctx, req)
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			ctx, err := a.currentRole(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err = a.authorize(ctx, tr.Operation(), req); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"errors"
+	"testing"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// authzConf 与 configs/config.yaml 相同的角色划分
+func authzConf() *conf.Auth {
+	return &conf.Auth{
+		Jwt: &conf.Auth_JWT{PublicOperations: []string{v1.OperationBlogServiceGetArticle}},
+		Authz: &conf.Auth_Authz{Roles: []*conf.Auth_Authz_Role{
+			{Name: "admin", Operations: []string{"*"}},
+			{Name: "editor", Operations: []string{"/blog.v1.BlogService/*", "/blog.v1.CommentService/*", v1.OperationUserServiceGetMe}},
+			{Name: "author",
+				Operations:    []string{v1.OperationBlogServiceCreateArticle, v1.OperationUserServiceGetMe},
+				OwnOperations: []string{v1.OperationBlogServiceUpdateArticle, v1.OperationBlogServiceDeleteArticle}},
+		}},
+	}
+}
+
+var errOwnerLookup = errors.New("db down")
+
+// newTestAuthz 用户 1 admin、2 editor、3 和 4 author、6 的角色未定义，5 已删除；
+// 文章 10 属于 3，文章 500 查询出错，其余文章不存在
+func newTestAuthz(t *testing.T) *Authz {
+	t.Helper()
+	users, err := biz.NewUserUsecase(authzConf(), nil, nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewAuthz(authzConf(), biz.NewArticleUsecase(authzConf(), nil, nil, nil, log.DefaultLogger), users, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := func(ctx context.Context, req interface{}) (int64, error) {
+		switch req.(interface{ GetId() int64 }).GetId() {
+		case 10:
+			return 3, nil
+		case 500:
+			return 0, errOwnerLookup
+		}
+		return 0, biz.ErrArticleNotFound
+	}
+	for op := range a.owners {
+		a.owners[op] = owner
+	}
+	roles := map[int64]string{1: "admin", 2: "editor", 3: "author", 4: "author", 6: "intern"}
+	a.roleOf = func(ctx context.Context, id int64) (string, error) {
+		if r, ok := roles[id]; ok {
+			return r, nil
+		}
+		return "", biz.ErrUnauthenticated
+	}
+	return a
+}
+
+func TestAuthzRoles(t *testing.T) {
+	h := newTestAuthz(t).Server()(callerHandler)
+	const (
+		ok = iota
+		denied
+		unauthenticated
+		internal
+	)
+	tests := []struct {
+		name      string
+		user      int64  // 0 为匿名
+		tokenRole string // 令牌里的角色，可能已过时
+		op        string
+		article   int64
+		want      int
+	}{
+		{"anonymous public", 0, "", v1.OperationBlogServiceGetArticle, 10, ok},
+		{"anonymous private", 0, "", v1.OperationBlogServiceCreateArticle, 0, unauthenticated},
+		{"admin anything", 1, "admin", v1.OperationUserServiceSetUserRole, 0, ok},
+		{"admin others' article", 1, "admin", v1.OperationBlogServiceDeleteArticle, 10, ok},
+		{"editor by prefix", 2, "editor", v1.OperationBlogServiceUpdateArticle, 10, ok},
+		{"editor comments", 2, "editor", v1.OperationCommentServiceModerateComment, 0, ok},
+		{"editor set role", 2, "editor", v1.OperationUserServiceSetUserRole, 0, denied},
+		{"author create", 3, "author", v1.OperationBlogServiceCreateArticle, 0, ok},
+		{"author own article", 3, "author", v1.OperationBlogServiceUpdateArticle, 10, ok},
+		{"author others' article", 4, "author", v1.OperationBlogServiceUpdateArticle, 10, denied},
+		{"author missing article", 3, "author", v1.OperationBlogServiceDeleteArticle, 404, denied},
+		{"author owner lookup fails", 3, "author", v1.OperationBlogServiceDeleteArticle, 500, internal},
+		{"author moderate", 3, "author", v1.OperationCommentServiceModerateComment, 0, denied},
+		{"demoted admin", 3, "admin", v1.OperationUserServiceSetUserRole, 0, denied},
+		{"promoted author", 1, "author", v1.OperationUserServiceSetUserRole, 0, ok},
+		{"deleted user", 5, "admin", v1.OperationUserServiceGetMe, 0, unauthenticated},
+		{"deleted user on public operation", 5, "admin", v1.OperationBlogServiceGetArticle, 10, unauthenticated},
+		{"undefined role", 6, "admin", v1.OperationUserServiceGetMe, 0, denied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := serverContext(tt.op)
+			if tt.user > 0 {
+				ctx = biz.NewCallerContext(ctx, &biz.Caller{UserId: tt.user, Role: tt.tokenRole})
+			}
+			_, err := h(ctx, &v1.UpdateArticleRequest{Id: tt.article})
+			switch tt.want {
+			case ok:
+				if err != nil {
+					t.Fatalf("err = %v", err)
+				}
+			case denied:
+				if !v1.IsPermissionDenied(err) {
+					t.Fatalf("err = %v, want permission denied", err)
+				}
+			case unauthenticated:
+				if !biz.ErrUnauthenticated.Is(err) {
+					t.Fatalf("err = %v, want unauthenticated", err)
+				}
+			case internal:
+				if !errors.Is(err, errOwnerLookup) {
+					t.Fatalf("err = %v, want the lookup error", err)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthzOwnerErrorsIndistinguishable(t *testing.T) {
+	h := newTestAuthz(t).Server()(callerHandler)
+	call := func(article int64) *kerrors.Error {
+		ctx := biz.NewCallerContext(serverContext(v1.OperationBlogServiceUpdateArticle), &biz.Caller{UserId: 4, Role: "author"})
+		_, err := h(ctx, &v1.UpdateArticleRequest{Id: article})
+		return kerrors.FromError(err)
+	}
+	missing, foreign := call(404), call(10)
+	if missing.Code != foreign.Code || missing.Reason != foreign.Reason || missing.Message != foreign.Message {
+		t.Fatalf("missing %v differs from foreign %v", missing, foreign)
+	}
+}
+
+func TestAuthzCurrentRole(t *testing.T) {
+	h := newTestAuthz(t).Server()(callerHandler)
+	ctx := biz.NewCallerContext(serverContext(v1.OperationUserServiceGetMe), &biz.Caller{UserId: 2, Username: "bob", Role: "admin"})
+	res, err := h(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := res.(*biz.Caller); c.Role != "editor" || c.Username != "bob" {
+		t.Fatalf("caller = %+v, want the stored role", c)
+	}
+}
+
+func TestNewAuthzConfig(t *testing.T) {
+	users, err := biz.NewUserUsecase(&conf.Auth{}, nil, nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	article := biz.NewArticleUsecase(&conf.Auth{}, nil, nil, nil, log.DefaultLogger)
+	for name, roles := range map[string][]*conf.Auth_Authz_Role{
+		"no name":           {{Operations: []string{"*"}}},
+		"defined twice":     {{Name: "a"}, {Name: "a"}},
+		"unowned operation": {{Name: "a", OwnOperations: []string{v1.OperationUserServiceGetMe}}},
+	} {
+		if _, err := NewAuthz(&conf.Auth{Authz: &conf.Auth_Authz{Roles: roles}}, article, users, log.DefaultLogger); err == nil {
+			t.Errorf("%s: NewAuthz should fail", name)
+		}
+	}
+}
+
+func TestAuthzApiKeyCallers(t *testing.T) {
+	h := newTestAuthz(t).Server()(callerHandler)
+	tests := []struct {
+		name    string
+		creator int64
+		op      string
+		article int64
+		allowed bool
+	}{
+		{"author key, role operation", 3, v1.OperationBlogServiceCreateArticle, 0, true},
+		{"author key, own article", 3, v1.OperationBlogServiceUpdateArticle, 10, true},
+		{"author key, others' article", 4, v1.OperationBlogServiceUpdateArticle, 10, false},
+		{"author key, beyond the role", 3, v1.OperationUserServiceSetUserRole, 0, false},
+		{"admin key", 1, v1.OperationUserServiceSetUserRole, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// scopes 为 * 也受创建者角色限制
+			ctx := biz.NewCallerContext(serverContext(tt.op), &biz.Caller{UserId: tt.creator, ApiKeyId: 9, Scopes: []string{"*"}})
+			res, err := h(ctx, &v1.UpdateArticleRequest{Id: tt.article})
+			if tt.allowed != (err == nil) {
+				t.Fatalf("err = %v, want allowed %v", err, tt.allowed)
+			}
+			if err == nil && res.(*biz.Caller).ApiKeyId != 9 {
+				t.Fatalf("caller = %+v", res)
+			}
+			if err != nil && !v1.IsPermissionDenied(err) {
+				t.Fatalf("err = %v, want permission denied", err)
+			}
+		})
+	}
+	ctx := biz.NewCallerContext(serverContext(v1.OperationUserServiceGetMe), &biz.Caller{UserId: 5, ApiKeyId: 9, Scopes: []string{"*"}})
+	if _, err := h(ctx, nil); !biz.ErrUnauthenticated.Is(err) {
+		t.Fatalf("key of a deleted user err = %v", err)
+	}
+}
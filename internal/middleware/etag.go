@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// articleReply 含文章的响应：Get/Create/Update/Restore/RollbackArticleReply
+type articleReply interface {
+	GetArticle() *v1.Article
+}
+
+// ETag 把文章 version 映射为 ETag 响应头，并把 If-Match 请求头作为 Update/RollbackArticle 的期望版本
+func ETag() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			if v, ok := parseETag(tr.RequestHeader().Get("If-Match")); ok {
+				switch in := req.(type) {
+				case *v1.UpdateArticleRequest:
+					if in.Version == 0 {
+						in.Version = v
+					}
+				case *v1.RollbackArticleRequest:
+					if in.Version == 0 {
+						in.Version = v
+					}
+				}
+			}
+			reply, err = handler(ctx, req)
+			if err != nil {
+				return
+			}
+			if r, ok := reply.(articleReply); ok && r.GetArticle().GetVersion() > 0 {
+				tr.ReplyHeader().Set("ETag", formatETag(r.GetArticle().GetVersion()))
+			}
+			return
+		}
+	}
+}
+
+func formatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseETag 解析 "3" 或 W/"3"；"*" 及无法识别的值视为不做校验
+func parseETag(s string) (int64, bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "W/")
+	s = strings.Trim(s, `"`)
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || v <= 0 {
+		return 0, false
+	}
+	return v, true
+}
@@ -0,0 +1,333 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultTokenTTL = time.Hour
+	minJWTSecretLen = 32
+	// placeholderJWTSecret 早先示例配置里的占位密钥，人人可见，不能用于签名
+	placeholderJWTSecret = "change-me-to-a-random-32-byte-secret"
+)
+
+// jwtClaims 令牌载荷：sub 为用户 id，name 为用户名，role 为签发时的角色
+type jwtClaims struct {
+	Name string `json:"name,omitempty"`
+	Role string `json:"role,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// JWT 校验 Authorization: Bearer <token> 并把调用方写入 context，同时为 Login 签发令牌
+type JWT struct {
+	method   jwt.SigningMethod // nil 表示未配置，只放行公开操作和已通过 api key 认证的调用方
+	keys     map[string]interface{}
+	signKey  interface{} // nil 表示不签发令牌
+	keyId    string
+	issuer   string
+	audience string
+	ttl      time.Duration
+	leeway   time.Duration
+	public   []string
+	log      *log.Helper
+}
+
+func NewJWT(c *conf.Auth, logger log.Logger) (*JWT, error) {
+	jc := c.GetJwt()
+	j := &JWT{
+		keyId:    jc.GetKeyId(),
+		issuer:   jc.GetIssuer(),
+		audience: jc.GetAudience(),
+		ttl:      jc.GetTokenTtl().AsDuration(),
+		leeway:   jc.GetLeeway().AsDuration(),
+		public:   jc.GetPublicOperations(),
+		log:      log.NewHelper(logger),
+	}
+	if jc.GetAlgorithm() == "" {
+		j.log.Warn("jwt is not configured, only public operations and api keys are accepted")
+		return j, nil
+	}
+	if j.ttl <= 0 {
+		j.ttl = defaultTokenTTL
+	}
+	var err error
+	switch jc.Algorithm {
+	case "HS256":
+		var secret []byte
+		if secret, err = loadSecret(jc); err != nil {
+			return nil, err
+		}
+		j.method = jwt.SigningMethodHS256
+		j.keys = map[string]interface{}{"": secret}
+		j.signKey = secret
+	case "RS256", "EdDSA":
+		j.method = jwt.GetSigningMethod(jc.Algorithm)
+		if j.keys, err = loadJWKS(jc.JwksFile, jc.Algorithm); err != nil {
+			return nil, err
+		}
+		if jc.PrivateKeyFile != "" {
+			if j.signKey, err = loadPrivateKey(jc.PrivateKeyFile, jc.Algorithm); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported jwt algorithm %q", jc.Algorithm)
+	}
+	return j, nil
+}
+
+// loadSecret 依次取 secret_file、secret_env、secret，拒绝过短或示例中的占位密钥
+func loadSecret(jc *conf.Auth_JWT) ([]byte, error) {
+	secret := jc.Secret
+	switch {
+	case jc.SecretFile != "":
+		b, err := os.ReadFile(jc.SecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("read jwt secret: %w", err)
+		}
+		secret = strings.TrimSpace(string(b))
+	case jc.SecretEnv != "":
+		secret = os.Getenv(jc.SecretEnv)
+		if secret == "" {
+			return nil, fmt.Errorf("jwt secret env %s is not set", jc.SecretEnv)
+		}
+	}
+	if secret == "" {
+		return nil, fmt.Errorf("jwt algorithm HS256 needs a secret")
+	}
+	if secret == placeholderJWTSecret {
+		return nil, fmt.Errorf("jwt secret is the example placeholder, generate a random one")
+	}
+	if len(secret) < minJWTSecretLen {
+		return nil, fmt.Errorf("jwt secret must be at least %d bytes", minJWTSecretLen)
+	}
+	return []byte(secret), nil
+}
+
+// jwk 只解析验签需要的字段
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+}
+
+// loadJWKS 读取 JWKS 文件中与算法匹配的签名公钥，按 kid 索引
+func loadJWKS(path, alg string) (map[string]interface{}, error) {
+	if path == "" {
+		return nil, fmt.Errorf("jwt algorithm %s needs jwks_file", alg)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks: %w", err)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, k := range set.Keys {
+		if (k.Use != "" && k.Use != "sig") || (k.Alg != "" && k.Alg != alg) {
+			continue
+		}
+		var key interface{}
+		switch {
+		case alg == "RS256" && k.Kty == "RSA":
+			key, err = k.rsaKey()
+		case alg == "EdDSA" && k.Kty == "OKP" && k.Crv == "Ed25519":
+			key, err = k.ed25519Key()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwks %s has no %s signing key", path, alg)
+	}
+	return keys, nil
+}
+
+func (k *jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+}
+
+func (k *jwk) ed25519Key() (ed25519.PublicKey, error) {
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil || len(x) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key")
+	}
+	return ed25519.PublicKey(x), nil
+}
+
+func loadPrivateKey(path, alg string) (interface{}, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwt private key: %w", err)
+	}
+	var key interface{}
+	if alg == "RS256" {
+		key, err = jwt.ParseRSAPrivateKeyFromPEM(b)
+	} else {
+		key, err = jwt.ParseEdPrivateKeyFromPEM(b)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse jwt private key: %w", err)
+	}
+	return key, nil
+}
+
+// IssueToken 未配置签名密钥时返回 nil
+func (j *JWT) IssueToken(_ context.Context, c *biz.Caller) (*biz.AccessToken, error) {
+	if j.signKey == nil {
+		return nil, nil
+	}
+	now := time.Now()
+	claims := &jwtClaims{
+		Name: c.Username,
+		Role: c.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(c.UserId, 10),
+			Issuer:    j.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(j.ttl)),
+		},
+	}
+	if j.audience != "" {
+		claims.Audience = jwt.ClaimStrings{j.audience}
+	}
+	token := jwt.NewWithClaims(j.method, claims)
+	if j.keyId != "" {
+		token.Header["kid"] = j.keyId
+	}
+	s, err := token.SignedString(j.signKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign token: %w", err)
+	}
+	return &biz.AccessToken{Token: s, ExpiresAt: claims.ExpiresAt.Time}, nil
+}
+
+func (j *JWT) keyFunc(t *jwt.Token) (interface{}, error) {
+	if j.method == jwt.SigningMethodHS256 {
+		return j.keys[""], nil
+	}
+	kid, _ := t.Header["kid"].(string)
+	if key, ok := j.keys[kid]; ok {
+		return key, nil
+	}
+	// 没有 kid 时只在唯一密钥的情况下放行
+	if kid == "" && len(j.keys) == 1 {
+		for _, key := range j.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// verify 校验签名、有效期、签发方和受众，返回令牌代表的调用方
+func (j *JWT) verify(token string) (*biz.Caller, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{j.method.Alg()}),
+		jwt.WithLeeway(j.leeway),
+		jwt.WithExpirationRequired(),
+	}
+	if j.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(j.issuer))
+	}
+	if j.audience != "" {
+		opts = append(opts, jwt.WithAudience(j.audience))
+	}
+	var claims jwtClaims
+	if _, err := jwt.ParseWithClaims(token, &claims, j.keyFunc, opts...); err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("invalid subject %q", claims.Subject)
+	}
+	return &biz.Caller{UserId: id, Username: claims.Name, Role: claims.Role}, nil
+}
+
+// matchOperation 操作名精确匹配，或以 * 结尾时按前缀匹配
+func matchOperation(patterns []string, operation string) bool {
+	for _, p := range patterns {
+		if p == operation {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(p, "*"); ok && strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Server 公开操作允许匿名访问，但带了令牌仍然要求令牌有效；其余操作必须携带有效令牌。
+// 未配置算法时不接受任何令牌，非公开操作只能通过 api key 访问
+func (j *JWT) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if _, ok := biz.CallerFromContext(ctx); ok {
+				return handler(ctx, req) // 已通过 api key 认证
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			auth := tr.RequestHeader().Get("Authorization")
+			if auth == "" || j.method == nil {
+				if matchOperation(j.public, tr.Operation()) {
+					return handler(ctx, req)
+				}
+				if auth != "" {
+					return nil, v1.ErrorUnauthenticated("bearer tokens are not accepted")
+				}
+				return nil, v1.ErrorUnauthenticated("missing bearer token")
+			}
+			scheme, token, ok := strings.Cut(auth, " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				return nil, v1.ErrorUnauthenticated("malformed Authorization header")
+			}
+			caller, err := j.verify(strings.TrimSpace(token))
+			if err != nil {
+				// 具体原因只记日志，不把解析细节返回给调用方
+				j.log.WithContext(ctx).Infof("reject token for %s: %v", tr.Operation(), err)
+				return nil, v1.ErrorUnauthenticated("invalid token")
+			}
+			return handler(biz.NewCallerContext(ctx, caller), req)
+		}
+	}
+}
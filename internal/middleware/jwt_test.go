@@ -0,0 +1,389 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// fakeTransport 只提供中间件用到的操作名和请求头
+type fakeTransport struct {
+	operation string
+	header    http.Header
+}
+
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return t.operation }
+func (t *fakeTransport) RequestHeader() transport.Header { return headerCarrier(t.header) }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return headerCarrier(http.Header{}) }
+
+// serverContext 返回调用 operation 的请求 ctx，kv 为成对的请求头
+func serverContext(operation string, kv ...string) context.Context {
+	h := http.Header{}
+	for i := 0; i+1 < len(kv); i += 2 {
+		h.Set(kv[i], kv[i+1])
+	}
+	return transport.NewServerContext(context.Background(), &fakeTransport{operation: operation, header: h})
+}
+
+// callerHandler 返回 ctx 中的调用方
+func callerHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	c, _ := biz.CallerFromContext(ctx)
+	return c, nil
+}
+
+func hsConf(mod func(*conf.Auth_JWT)) *conf.Auth {
+	jc := &conf.Auth_JWT{
+		Algorithm:        "HS256",
+		Secret:           testSecret,
+		Issuer:           "agdemo",
+		Audience:         "blog",
+		Leeway:           durationpb.New(10 * time.Second),
+		PublicOperations: []string{v1.OperationBlogServiceGetArticle},
+	}
+	if mod != nil {
+		mod(jc)
+	}
+	return &conf.Auth{Jwt: jc}
+}
+
+func newTestJWT(t *testing.T, c *conf.Auth) *JWT {
+	t.Helper()
+	j, err := NewJWT(c, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return j
+}
+
+// sign 用 key 按 method 签出 claims，kid 为空时不带 kid 头
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims jwt.Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// validClaims 可以通过 hsConf 校验的载荷
+func validClaims() *jwtClaims {
+	now := time.Now()
+	return &jwtClaims{
+		Name: "alice",
+		Role: "author",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "7",
+			Issuer:    "agdemo",
+			Audience:  jwt.ClaimStrings{"blog"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+	}
+}
+
+func TestNewJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "secret")
+	if err := os.WriteFile(file, []byte(testSecret+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_JWT_SECRET", testSecret)
+	tests := []struct {
+		name string
+		mod  func(*conf.Auth_JWT)
+		ok   bool
+	}{
+		{"inline", nil, true},
+		{"empty", func(jc *conf.Auth_JWT) { jc.Secret = "" }, false},
+		{"placeholder", func(jc *conf.Auth_JWT) { jc.Secret = placeholderJWTSecret }, false},
+		{"too short", func(jc *conf.Auth_JWT) { jc.Secret = "short" }, false},
+		{"env", func(jc *conf.Auth_JWT) { jc.Secret, jc.SecretEnv = "", "TEST_JWT_SECRET" }, true},
+		{"env unset", func(jc *conf.Auth_JWT) { jc.SecretEnv = "TEST_JWT_SECRET_UNSET" }, false},
+		{"file", func(jc *conf.Auth_JWT) { jc.Secret, jc.SecretFile = "", file }, true},
+		{"file missing", func(jc *conf.Auth_JWT) { jc.SecretFile = filepath.Join(dir, "missing") }, false},
+		{"unknown algorithm", func(jc *conf.Auth_JWT) { jc.Algorithm = "HS512" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j, err := NewJWT(hsConf(tt.mod), log.DefaultLogger)
+			if (err == nil) != tt.ok {
+				t.Fatalf("NewJWT err = %v, want ok %v", err, tt.ok)
+			}
+			if tt.ok && string(j.signKey.([]byte)) != testSecret {
+				t.Fatalf("secret = %q", j.signKey)
+			}
+		})
+	}
+}
+
+func TestJWTVerify(t *testing.T) {
+	j := newTestJWT(t, hsConf(nil))
+	other := []byte(strings.Repeat("x", 32))
+	tests := []struct {
+		name  string
+		token func() string
+		ok    bool
+	}{
+		{"valid", func() string { return sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims()) }, true},
+		{"issued by IssueToken", func() string {
+			tok, err := j.IssueToken(context.Background(), &biz.Caller{UserId: 7, Username: "alice", Role: "author"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return tok.Token
+		}, true},
+		{"bad signature", func() string { return sign(t, jwt.SigningMethodHS256, other, "", validClaims()) }, false},
+		{"tampered payload", func() string {
+			s := sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims())
+			parts := strings.Split(s, ".")
+			c := validClaims()
+			c.Role = "admin"
+			b, _ := json.Marshal(c)
+			parts[1] = base64.RawURLEncoding.EncodeToString(b)
+			return strings.Join(parts, ".")
+		}, false},
+		{"wrong alg", func() string { return sign(t, jwt.SigningMethodHS384, []byte(testSecret), "", validClaims()) }, false},
+		{"alg none", func() string {
+			return sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", validClaims())
+		}, false},
+		{"expired", func() string {
+			c := validClaims()
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			return sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", c)
+		}, false},
+		{"expired within leeway", func() string {
+			c := validClaims()
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-2 * time.Second))
+			return sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", c)
+		}, true},
+		{"no expiry", func() string {
+			c := validClaims()
+			c.ExpiresAt = nil
+			return sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", c)
+		}, false},
+		{"bad issuer", func() string {
+			c := validClaims()
+			c.Issuer = "evil"
+			return sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", c)
+		}, false},
+		{"bad audience", func() string {
+			c := validClaims()
+			c.Audience = jwt.ClaimStrings{"other"}
+			return sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", c)
+		}, false},
+		{"bad subject", func() string {
+			c := validClaims()
+			c.Subject = "alice"
+			return sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", c)
+		}, false},
+		{"garbage", func() string { return "not.a.token" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := j.verify(tt.token())
+			if (err == nil) != tt.ok {
+				t.Fatalf("verify err = %v, want ok %v", err, tt.ok)
+			}
+			if tt.ok && (caller.UserId != 7 || caller.Username != "alice" || caller.Role != "author") {
+				t.Fatalf("caller = %+v", caller)
+			}
+		})
+	}
+}
+
+func writeJSON(t *testing.T, path string, v interface{}) {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func ed25519JWK(kid string, pub ed25519.PublicKey) map[string]string {
+	return map[string]string{"kty": "OKP", "crv": "Ed25519", "kid": kid, "x": base64.RawURLEncoding.EncodeToString(pub)}
+}
+
+func TestJWTKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+	oldPub, oldKey, _ := ed25519.GenerateKey(rand.Reader)
+	newPub, newKey, _ := ed25519.GenerateKey(rand.Reader)
+	der, err := x509.MarshalPKCS8PrivateKey(newKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, "key.pem")
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	jwks := filepath.Join(dir, "jwks.json")
+	newJWT := func(keys ...map[string]string) *JWT {
+		writeJSON(t, jwks, map[string]interface{}{"keys": keys})
+		return newTestJWT(t, hsConf(func(jc *conf.Auth_JWT) {
+			jc.Algorithm, jc.Secret, jc.JwksFile, jc.PrivateKeyFile, jc.KeyId = "EdDSA", "", jwks, keyFile, "new"
+		}))
+	}
+
+	// 轮换期间新旧公钥同时发布
+	j := newJWT(ed25519JWK("old", oldPub), ed25519JWK("new", newPub))
+	issued, err := j.IssueToken(context.Background(), &biz.Caller{UserId: 7, Username: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldToken := sign(t, jwt.SigningMethodEdDSA, oldKey, "old", validClaims())
+	for name, tc := range map[string]struct {
+		token string
+		ok    bool
+	}{
+		"issued with the new key": {issued.Token, true},
+		"signed with the old key": {oldToken, true},
+		"unknown kid":             {sign(t, jwt.SigningMethodEdDSA, oldKey, "gone", validClaims()), false},
+		"no kid with two keys":    {sign(t, jwt.SigningMethodEdDSA, newKey, "", validClaims()), false},
+		"old key under new kid":   {sign(t, jwt.SigningMethodEdDSA, oldKey, "new", validClaims()), false},
+	} {
+		if _, err := j.verify(tc.token); (err == nil) != tc.ok {
+			t.Errorf("%s: verify err = %v, want ok %v", name, err, tc.ok)
+		}
+	}
+
+	// 旧公钥撤下后旧令牌失效
+	j = newJWT(ed25519JWK("new", newPub))
+	if _, err = j.verify(oldToken); err == nil {
+		t.Fatal("token of a retired key accepted")
+	}
+	if _, err = j.verify(issued.Token); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = j.verify(sign(t, jwt.SigningMethodEdDSA, newKey, "", validClaims())); err != nil {
+		t.Fatalf("no kid with a single key: %v", err)
+	}
+}
+
+func TestJWTRejectsAlgorithmConfusion(t *testing.T) {
+	dir := t.TempDir()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := filepath.Join(dir, "jwks.json")
+	writeJSON(t, jwks, map[string]interface{}{"keys": []map[string]string{{
+		"kty": "RSA", "kid": "k",
+		"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e": base64.RawURLEncoding.EncodeToString([]byte{1, 0, 1}),
+	}}})
+	j := newTestJWT(t, hsConf(func(jc *conf.Auth_JWT) { jc.Algorithm, jc.Secret, jc.JwksFile = "RS256", "", jwks }))
+
+	if _, err = j.verify(sign(t, jwt.SigningMethodRS256, key, "k", validClaims())); err != nil {
+		t.Fatal(err)
+	}
+	// 用公钥当 HMAC 密钥签出的令牌
+	pub, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if _, err = j.verify(sign(t, jwt.SigningMethodHS256, pub, "k", validClaims())); err == nil {
+		t.Fatal("HS256 token accepted by an RS256 verifier")
+	}
+}
+
+func TestJWTServer(t *testing.T) {
+	j := newTestJWT(t, hsConf(nil))
+	h := j.Server()(callerHandler)
+	token := sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims())
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		caller int64 // 0 为匿名
+		msg    string
+	}{
+		{"public anonymous", serverContext(v1.OperationBlogServiceGetArticle), 0, ""},
+		{"public with token", serverContext(v1.OperationBlogServiceGetArticle, "Authorization", "Bearer "+token), 7, ""},
+		{"private anonymous", serverContext(v1.OperationBlogServiceCreateArticle), 0, "missing bearer token"},
+		{"private with token", serverContext(v1.OperationBlogServiceCreateArticle, "Authorization", "bearer "+token), 7, ""},
+		{"malformed header", serverContext(v1.OperationBlogServiceCreateArticle, "Authorization", token), 0, "malformed Authorization header"},
+		{"expired token", serverContext(v1.OperationBlogServiceGetArticle, "Authorization",
+			"Bearer "+sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", expired)), 0, "invalid token"},
+		{"api key caller", biz.NewCallerContext(serverContext(v1.OperationBlogServiceCreateArticle), &biz.Caller{UserId: 3, ApiKeyId: 1}), 3, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := h(tt.ctx, nil)
+			if tt.msg != "" {
+				if e := errors.FromError(err); !v1.IsUnauthenticated(err) || e.Message != tt.msg {
+					t.Fatalf("err = %v, want unauthenticated %q", err, tt.msg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, _ := res.(*biz.Caller)
+			if (c == nil && tt.caller != 0) || (c != nil && c.UserId != tt.caller) {
+				t.Fatalf("caller = %+v, want %d", c, tt.caller)
+			}
+		})
+	}
+}
+
+func TestJWTServerUnconfigured(t *testing.T) {
+	j := newTestJWT(t, &conf.Auth{Jwt: &conf.Auth_JWT{PublicOperations: []string{v1.OperationBlogServiceGetArticle}}})
+	if _, err := j.IssueToken(context.Background(), &biz.Caller{UserId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	h := j.Server()(callerHandler)
+	if _, err := h(serverContext(v1.OperationBlogServiceGetArticle), nil); err != nil {
+		t.Fatalf("public operation: %v", err)
+	}
+	for _, ctx := range []context.Context{
+		serverContext(v1.OperationBlogServiceCreateArticle),
+		serverContext(v1.OperationBlogServiceCreateArticle, "Authorization", "Bearer anything"),
+	} {
+		if _, err := h(ctx, nil); !v1.IsUnauthenticated(err) {
+			t.Fatalf("private operation without jwt err = %v", err)
+		}
+	}
+}
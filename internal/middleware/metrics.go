@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
+	"go.opentelemetry.io/otel"
+)
+
+// 导出为 server_requests_total{kind,operation,code,reason} 与 server_requests_duration_seconds{kind,operation}
+const (
+	requestsCounterName  = "server.requests"
+	secondsHistogramName = "server.requests.duration"
+	middlewareMeterName  = "agdemo/internal/middleware"
+)
+
+// Metrics 按操作统计请求数、错误码与耗时，两个 server 共用同一组指标
+type Metrics struct {
+	opts []metrics.Option
+}
+
+func NewMetrics() (*Metrics, error) {
+	meter := otel.Meter(middlewareMeterName)
+	requests, err := metrics.DefaultRequestsCounter(meter, requestsCounterName)
+	if err != nil {
+		return nil, err
+	}
+	seconds, err := metrics.DefaultSecondsHistogram(meter, secondsHistogramName)
+	if err != nil {
+		return nil, err
+	}
+	return &Metrics{opts: []metrics.Option{metrics.WithRequests(requests), metrics.WithSeconds(seconds)}}, nil
+}
+
+// Server 需放在限流与认证之前，被拒绝的请求也要计入
+func (m *Metrics) Server() middleware.Middleware {
+	return metrics.Server(m.opts...)
+}
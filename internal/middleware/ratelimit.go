@@ -0,0 +1,243 @@
+package middleware
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	myRatelimit "agdemo/internal/middleware/ratelimit"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/peer"
+)
+
+// 限流维度
+const (
+	rateKeyCaller    = "caller"
+	rateKeyApiKey    = "api_key"
+	rateKeyUser      = "user"
+	rateKeyIP        = "ip"
+	rateKeyOperation = "operation"
+)
+
+type rateRule struct {
+	operation string
+	key       string
+	quota     myRatelimit.Quota
+}
+
+// RateLimit 按调用方和接口分别限流，需放在认证之后以便识别调用方；PreAuth 在认证之前按 ip 限流
+type RateLimit struct {
+	rules        []rateRule
+	defaultQuota *myRatelimit.Quota
+	preAuth      *myRatelimit.Quota
+	trustForward bool
+	buckets      myRatelimit.Buckets
+	rejections   metric.Int64Counter
+}
+
+func NewRateLimit(c *conf.Server, rdb *redis.Client, logger log.Logger) (*RateLimit, error) {
+	rc := c.GetRateLimit()
+	rejections, err := otel.Meter(middlewareMeterName).Int64Counter("ratelimit.rejections",
+		metric.WithDescription("Requests rejected by the per client quotas, by operation and rule"))
+	if err != nil {
+		return nil, err
+	}
+	local := myRatelimit.NewKeyedLimiter(int(rc.GetMaxBuckets()))
+	r := &RateLimit{
+		trustForward: rc.GetTrustForwardedFor(),
+		buckets:      local,
+		rejections:   rejections,
+	}
+	switch rc.GetBackend() {
+	case "", "local":
+	case "redis":
+		rl, err := myRatelimit.NewRedisLimiter(rdb, rc.GetAlgorithm(), rc.GetRedisTimeout().AsDuration(), local, logger)
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit: %w", err)
+		}
+		r.buckets = rl
+	default:
+		return nil, fmt.Errorf("rate_limit: unknown backend %q", rc.GetBackend())
+	}
+	for i, rule := range rc.GetRules() {
+		if rule.Operation == "" {
+			return nil, fmt.Errorf("rate_limit.rules[%d]: operation is required", i)
+		}
+		key := rule.Key
+		switch key {
+		case "":
+			key = rateKeyCaller
+		case rateKeyCaller, rateKeyApiKey, rateKeyUser, rateKeyIP, rateKeyOperation:
+		default:
+			return nil, fmt.Errorf("rate_limit.rules[%d]: unknown key %q", i, rule.Key)
+		}
+		q, err := quotaOf(rule.Quota)
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit.rules[%d]: %w", i, err)
+		}
+		r.rules = append(r.rules, rateRule{operation: rule.Operation, key: key, quota: q})
+	}
+	if rc.GetDefaultQuota() != nil {
+		q, err := quotaOf(rc.DefaultQuota)
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit.default_quota: %w", err)
+		}
+		r.defaultQuota = &q
+	}
+	if rc.GetPreAuth() != nil {
+		q, err := quotaOf(rc.PreAuth)
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit.pre_auth: %w", err)
+		}
+		r.preAuth = &q
+	}
+	return r, nil
+}
+
+func quotaOf(q *conf.Server_RateLimit_Quota) (myRatelimit.Quota, error) {
+	if q.GetRate() <= 0 {
+		return myRatelimit.Quota{}, fmt.Errorf("quota rate must be positive")
+	}
+	burst := q.GetBurst()
+	if burst <= 0 {
+		burst = q.GetRate()
+	}
+	return myRatelimit.Quota{Rate: int(q.GetRate()), Burst: int(burst)}, nil
+}
+
+// match 第一条匹配的规则，都不匹配时使用默认配额，返回的 id 区分不同规则的桶
+func (r *RateLimit) match(operation string) (id string, key string, q myRatelimit.Quota, ok bool) {
+	for i, rule := range r.rules {
+		if matchOperation([]string{rule.operation}, operation) {
+			return strconv.Itoa(i), rule.key, rule.quota, true
+		}
+	}
+	if r.defaultQuota != nil {
+		return "default", rateKeyCaller, *r.defaultQuota, true
+	}
+	return "", "", myRatelimit.Quota{}, false
+}
+
+// bucketKey 调用方标识，api_key/user 识别不到调用方时退化为按 ip
+func (r *RateLimit) bucketKey(ctx context.Context, kind, operation string) string {
+	caller, _ := biz.CallerFromContext(ctx)
+	switch kind {
+	case rateKeyOperation:
+		return "op:" + operation
+	case rateKeyCaller, rateKeyApiKey:
+		if caller != nil && caller.ApiKeyId != 0 {
+			return "key:" + strconv.FormatInt(caller.ApiKeyId, 10)
+		}
+		if kind == rateKeyApiKey {
+			break
+		}
+		fallthrough
+	case rateKeyUser:
+		if caller != nil && caller.UserId != 0 {
+			return "user:" + strconv.FormatInt(caller.UserId, 10)
+		}
+	}
+	return "ip:" + r.clientIP(ctx)
+}
+
+func (r *RateLimit) clientIP(ctx context.Context) string {
+	if req, ok := http.RequestFromServerContext(ctx); ok {
+		if r.trustForward {
+			if xff := req.Header.Get("X-Forwarded-For"); xff != "" {
+				first, _, _ := strings.Cut(xff, ",")
+				return strings.TrimSpace(first)
+			}
+			if ip := req.Header.Get("X-Real-IP"); ip != "" {
+				return ip
+			}
+		}
+		return hostOf(req.RemoteAddr)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return hostOf(p.Addr.String())
+	}
+	return "unknown"
+}
+
+func hostOf(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// ceilSeconds 响应头里的秒数向上取整
+func ceilSeconds(d time.Duration) string {
+	return strconv.FormatInt(int64((d+time.Second-1)/time.Second), 10)
+}
+
+// take 从 rule 规则下 key 的桶中取一个令牌，在响应头中返回 X-RateLimit-Limit/Remaining/Reset，
+// 超限时附带 Retry-After 并返回 429
+func (r *RateLimit) take(ctx context.Context, tr transport.Transporter, rule, key string, q myRatelimit.Quota) error {
+	res := r.buckets.Take(ctx, rule+"|"+key, q)
+	h := tr.ReplyHeader()
+	h.Set("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	h.Set("X-RateLimit-Reset", ceilSeconds(res.Reset))
+	if !res.Allowed {
+		h.Set("Retry-After", ceilSeconds(res.RetryAfter))
+		r.rejections.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("operation", tr.Operation()),
+			attribute.String("rule", rule),
+		))
+		return ratelimit.ErrLimitExceed
+	}
+	return nil
+}
+
+// PreAuth 需放在 APIKey 和 JWT 之前，此时还不知道调用方，只能按 ip 限流
+func (r *RateLimit) PreAuth() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if r.preAuth == nil {
+				return handler(ctx, req)
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			if err := r.take(ctx, tr, "pre_auth", "ip:"+r.clientIP(ctx), *r.preAuth); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Server 按第一条匹配的规则限流
+func (r *RateLimit) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			id, kind, q, ok := r.match(tr.Operation())
+			if !ok {
+				return handler(ctx, req)
+			}
+			if err := r.take(ctx, tr, id, r.bucketKey(ctx, kind, tr.Operation()), q); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/aegis/ratelimit"
+	"github.com/go-kratos/aegis/ratelimit/bbr"
+)
+
+// 自适应限流算法
+const (
+	AlgorithmBBR  = "bbr"
+	AlgorithmAIMD = "aimd"
+)
+
+// AdaptiveStat 自适应限流器的当前状态，用于导出指标
+type AdaptiveStat struct {
+	InFlight int64 // 正在处理的请求数
+	Limit    int64 // 当前允许的并发上限
+	CPU      int64 // CPU 使用率（千分比），只有 bbr 有值
+}
+
+// AdaptiveLimiter 根据服务自身负载决定是否放行，而不是固定速率
+type AdaptiveLimiter interface {
+	ratelimit.Limiter
+	Stat() AdaptiveStat
+}
+
+// BBRLimiter CPU 超过阈值且并发数超过 最大通过量×最小耗时 时丢弃请求
+type BBRLimiter struct {
+	*bbr.BBR
+}
+
+// NewBBRLimiter 参数为 0 时使用 bbr 的默认值（CPU 800‰、10s 窗口、100 个桶）
+func NewBBRLimiter(cpuThreshold int64, window time.Duration, buckets int) *BBRLimiter {
+	var opts []bbr.Option
+	if cpuThreshold > 0 {
+		opts = append(opts, bbr.WithCPUThreshold(cpuThreshold))
+	}
+	if window > 0 {
+		opts = append(opts, bbr.WithWindow(window))
+	}
+	if buckets > 0 {
+		opts = append(opts, bbr.WithBucket(buckets))
+	}
+	return &BBRLimiter{BBR: bbr.NewLimiter(opts...)}
+}
+
+func (l *BBRLimiter) Stat() AdaptiveStat {
+	s := l.BBR.Stat()
+	return AdaptiveStat{InFlight: s.InFlight, Limit: s.MaxInFlight, CPU: s.CPU}
+}
+
+// AIMDConfig AIMDLimiter 参数，零值字段使用默认值
+type AIMDConfig struct {
+	InitialLimit     int           // 初始并发上限，默认 20
+	MinLimit         int           // 默认 1
+	MaxLimit         int           // 默认 200
+	LatencyThreshold time.Duration // 超过该耗时视为过载，默认 500ms
+	BackoffRatio     float64       // 过载时上限乘以该比例，默认 0.9
+	Clock            Clock         // 默认系统时钟
+}
+
+// AIMDLimiter 并发数限流：请求变慢或超时时按比例降低并发上限，
+// 上限被用满且请求正常完成时逐个加一；不依赖 CPU，下游（如数据库）变慢时同样生效
+type AIMDLimiter struct {
+	mu        sync.Mutex
+	limit     float64
+	inFlight  int64
+	minLimit  float64
+	maxLimit  float64
+	threshold time.Duration
+	backoff   float64
+	clock     Clock
+}
+
+func NewAIMDLimiter(c AIMDConfig) *AIMDLimiter {
+	if c.MinLimit <= 0 {
+		c.MinLimit = 1
+	}
+	if c.MaxLimit <= 0 {
+		c.MaxLimit = 200
+	}
+	if c.InitialLimit <= 0 {
+		c.InitialLimit = 20
+	}
+	if c.LatencyThreshold <= 0 {
+		c.LatencyThreshold = 500 * time.Millisecond
+	}
+	if c.BackoffRatio <= 0 || c.BackoffRatio >= 1 {
+		c.BackoffRatio = 0.9
+	}
+	if c.Clock == nil {
+		c.Clock = systemClock{}
+	}
+	return &AIMDLimiter{
+		limit:     math.Min(math.Max(float64(c.InitialLimit), float64(c.MinLimit)), float64(c.MaxLimit)),
+		minLimit:  float64(c.MinLimit),
+		maxLimit:  float64(c.MaxLimit),
+		threshold: c.LatencyThreshold,
+		backoff:   c.BackoffRatio,
+		clock:     c.Clock,
+	}
+}
+
+// Allow 并发数达到上限时拒绝，返回的 DoneFunc 必须在请求结束时调用
+func (l *AIMDLimiter) Allow() (ratelimit.DoneFunc, error) {
+	l.mu.Lock()
+	if l.inFlight >= int64(l.limit) {
+		l.mu.Unlock()
+		return nil, ErrLimitExceed
+	}
+	l.inFlight++
+	l.mu.Unlock()
+
+	start := l.clock.Now()
+	return func(info ratelimit.DoneInfo) {
+		rt := l.clock.Now().Sub(start)
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		// 上限要在减少 inFlight 之前判断是否被用满，否则并发较低时也会一直增长
+		inFlight := l.inFlight
+		l.inFlight--
+		switch {
+		case rt > l.threshold || errors.Is(info.Err, context.DeadlineExceeded):
+			l.limit = math.Max(l.minLimit, l.limit*l.backoff)
+		case float64(inFlight*2) >= l.limit:
+			l.limit = math.Min(l.maxLimit, l.limit+1)
+		}
+	}, nil
+}
+
+func (l *AIMDLimiter) Stat() AdaptiveStat {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return AdaptiveStat{InFlight: l.inFlight, Limit: int64(l.limit)}
+}
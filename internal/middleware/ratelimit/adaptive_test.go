@@ -0,0 +1,134 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/aegis/ratelimit"
+	"github.com/go-kratos/aegis/ratelimit/bbr"
+)
+
+// admit 连续调用 Allow 直到被拒绝，返回放行请求的 DoneFunc
+func admit(l AdaptiveLimiter) []ratelimit.DoneFunc {
+	var done []ratelimit.DoneFunc
+	for i := 0; i < 1000; i++ {
+		d, err := l.Allow()
+		if err != nil {
+			break
+		}
+		done = append(done, d)
+	}
+	return done
+}
+
+func TestAIMDConcurrencyLimit(t *testing.T) {
+	l := NewAIMDLimiter(AIMDConfig{InitialLimit: 3, Clock: newFakeClock()})
+	done := admit(l)
+	if len(done) != 3 {
+		t.Fatalf("admitted %d requests, want 3", len(done))
+	}
+	if s := l.Stat(); s.InFlight != 3 || s.Limit != 3 {
+		t.Fatalf("stat = %+v, want 3 in flight, limit 3", s)
+	}
+	// 结束一个请求后腾出位置
+	done[0](ratelimit.DoneInfo{})
+	if _, err := l.Allow(); err != nil {
+		t.Fatalf("Allow after done: %v", err)
+	}
+}
+
+func TestAIMDBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		latency time.Duration
+		err     error
+		limit   int64
+	}{
+		{"fast", 100 * time.Millisecond, nil, 10},
+		{"slow", time.Second, nil, 9},
+		{"deadline", 100 * time.Millisecond, context.DeadlineExceeded, 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newFakeClock()
+			l := NewAIMDLimiter(AIMDConfig{InitialLimit: 10, LatencyThreshold: 500 * time.Millisecond, BackoffRatio: 0.9, Clock: clock})
+			done, err := l.Allow()
+			if err != nil {
+				t.Fatal(err)
+			}
+			clock.Advance(tt.latency)
+			done(ratelimit.DoneInfo{Err: tt.err})
+			if s := l.Stat(); s.Limit != tt.limit || s.InFlight != 0 {
+				t.Fatalf("stat = %+v, want limit %d and nothing in flight", s, tt.limit)
+			}
+		})
+	}
+}
+
+func TestAIMDIncrease(t *testing.T) {
+	clock := newFakeClock()
+	l := NewAIMDLimiter(AIMDConfig{InitialLimit: 4, MaxLimit: 5, Clock: clock})
+	// 上限用满一半以上时正常完成才加一
+	for i := 0; i < 3; i++ {
+		done := admit(l)
+		for _, d := range done {
+			d(ratelimit.DoneInfo{})
+		}
+	}
+	if s := l.Stat(); s.Limit != 5 {
+		t.Fatalf("limit = %d, want capped at 5", s.Limit)
+	}
+
+	// 并发较低时不增长
+	l = NewAIMDLimiter(AIMDConfig{InitialLimit: 10, Clock: clock})
+	for i := 0; i < 20; i++ {
+		done, _ := l.Allow()
+		done(ratelimit.DoneInfo{})
+	}
+	if s := l.Stat(); s.Limit != 10 {
+		t.Fatalf("limit at low concurrency = %d, want 10", s.Limit)
+	}
+}
+
+func TestAIMDMinLimit(t *testing.T) {
+	clock := newFakeClock()
+	l := NewAIMDLimiter(AIMDConfig{InitialLimit: 3, MinLimit: 2, BackoffRatio: 0.5, Clock: clock})
+	for i := 0; i < 5; i++ {
+		done, err := l.Allow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		clock.Advance(time.Second)
+		done(ratelimit.DoneInfo{})
+	}
+	if s := l.Stat(); s.Limit != 2 {
+		t.Fatalf("limit = %d, want floor 2", s.Limit)
+	}
+}
+
+func TestBBRDropsWhenOverloaded(t *testing.T) {
+	// 阈值为负时 CPU 总是视为过载，只看并发数
+	l := &BBRLimiter{BBR: bbr.NewLimiter(bbr.WithCPUThreshold(-1))}
+	done := admit(l)
+	if len(done) == 0 || len(done) >= 1000 {
+		t.Fatalf("admitted %d requests, want shedding to start", len(done))
+	}
+	if s := l.Stat(); s.InFlight != int64(len(done)) {
+		t.Fatalf("in flight = %d, want %d", s.InFlight, len(done))
+	}
+	for _, d := range done {
+		d(ratelimit.DoneInfo{})
+	}
+	if s := l.Stat(); s.InFlight != 0 {
+		t.Fatalf("in flight after done = %d, want 0", s.InFlight)
+	}
+}
+
+func TestBBRAdmitsUnderThreshold(t *testing.T) {
+	// CPU 使用率不会超过 1000‰，阈值之上不会丢弃请求
+	l := &BBRLimiter{BBR: bbr.NewLimiter(bbr.WithCPUThreshold(1001))}
+	if done := admit(l); len(done) != 1000 {
+		t.Fatalf("admitted %d requests, want all", len(done))
+	}
+}
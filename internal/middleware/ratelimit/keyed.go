@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+const defaultMaxBuckets = 10000
+
+// Quota 令牌桶参数
+type Quota struct {
+	Rate  int // 每秒补充的令牌数
+	Burst int // 桶容量
+}
+
+// Result 一次取令牌的结果，用于填充 X-RateLimit-* 响应头
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // 仅拒绝时有值
+	Reset      time.Duration // 桶重新装满所需的时间
+}
+
+// Buckets 按 key 分桶限流，本地实现为 KeyedLimiter，跨副本共享时用 RedisLimiter
+type Buckets interface {
+	Take(ctx context.Context, key string, q Quota) Result
+}
+
+type bucketEntry struct {
+	key    string
+	bucket *TokenBucketLimiter
+}
+
+// KeyedLimiter 按 key 维护独立的令牌桶，超出 maxBuckets 时淘汰最久未使用的桶
+type KeyedLimiter struct {
+	mu         sync.Mutex
+	maxBuckets int
+	lru        *list.List // front 为最近使用
+	buckets    map[string]*list.Element
+}
+
+func NewKeyedLimiter(maxBuckets int) *KeyedLimiter {
+	if maxBuckets <= 0 {
+		maxBuckets = defaultMaxBuckets
+	}
+	return &KeyedLimiter{
+		maxBuckets: maxBuckets,
+		lru:        list.New(),
+		buckets:    make(map[string]*list.Element),
+	}
+}
+
+// bucket 取出 key 对应的桶，不存在时按 q 创建；同一 key 的配额应保持不变
+func (l *KeyedLimiter) bucket(key string, q Quota) *TokenBucketLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e, ok := l.buckets[key]; ok {
+		l.lru.MoveToFront(e)
+		return e.Value.(*bucketEntry).bucket
+	}
+	b := NewTokenBucketLimiter(q.Rate, q.Burst)
+	l.buckets[key] = l.lru.PushFront(&bucketEntry{key: key, bucket: b})
+	for l.lru.Len() > l.maxBuckets {
+		oldest := l.lru.Back()
+		l.lru.Remove(oldest)
+		delete(l.buckets, oldest.Value.(*bucketEntry).key)
+	}
+	return b
+}
+
+// Take 从 key 对应的桶取一个令牌
+func (l *KeyedLimiter) Take(_ context.Context, key string, q Quota) Result {
+	b := l.bucket(key, q)
+	remaining, wait, ok := b.take(1)
+	return Result{
+		Allowed:    ok,
+		Limit:      q.Burst,
+		Remaining:  remaining,
+		RetryAfter: wait,
+		Reset:      b.untilFull(),
+	}
+}
+
+// Len 当前保留的桶数
+func (l *KeyedLimiter) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lru.Len()
+}
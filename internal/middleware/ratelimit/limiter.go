@@ -3,69 +3,126 @@ package ratelimit
 import (
 	"errors"
 	"github.com/go-kratos/aegis/ratelimit"
+	"math"
 	"sync"
 	"time"
 )
 
-// TokenBucketLimiter 基于令牌桶算法的限流器实现
+// Clock 时间来源，测试时可替换为手动推进的时钟
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// tokenUnit 令牌以十亿分之一为单位计数：按纳秒流逝补充时恰好是整数，不会累积浮点误差
+const tokenUnit = int64(time.Second)
+
+// TokenBucketLimiter 基于令牌桶算法的限流器实现，令牌按流逝时间连续补充（保留不足一个的部分）
 type TokenBucketLimiter struct {
-	rate       int        // 每秒产生的令牌数
-	capacity   int        // 桶的容量
-	tokens     int        // 当前令牌数
+	rate       int64      // 每秒产生的令牌数
+	capacity   int64      // 桶的容量，单位 tokenUnit
+	tokens     int64      // 当前令牌数，单位 tokenUnit
 	lastUpdate time.Time  // 上次更新时间
+	clock      Clock      // 时间来源
 	mu         sync.Mutex // 互斥锁保证并发安全
 }
 
-// DoneFunc is done function.
+// Option TokenBucketLimiter 选项
+type Option func(*TokenBucketLimiter)
 
-// DoneInfo is done info.
+// WithClock 替换时间来源
+func WithClock(c Clock) Option {
+	return func(l *TokenBucketLimiter) {
+		l.clock = c
+	}
+}
 
 // NewTokenBucketLimiter 创建令牌桶限流器
 // rate: 每秒允许的请求数
 // capacity: 桶容量（突发流量允许的最大请求数）
-func NewTokenBucketLimiter(rate, capacity int) *TokenBucketLimiter {
-	return &TokenBucketLimiter{
-		rate:       rate,
-		capacity:   capacity,
-		tokens:     capacity,
-		lastUpdate: time.Now(),
+func NewTokenBucketLimiter(rate, capacity int, opts ...Option) *TokenBucketLimiter {
+	l := &TokenBucketLimiter{
+		rate:     int64(rate),
+		capacity: int64(capacity) * tokenUnit,
+		tokens:   int64(capacity) * tokenUnit,
+		clock:    systemClock{},
+	}
+	for _, o := range opts {
+		o(l)
 	}
+	l.lastUpdate = l.clock.Now()
+	return l
 }
 
 // Allow 实现 Limiter 接口
 func (l *TokenBucketLimiter) Allow() (ratelimit.DoneFunc, error) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
+	return l.AllowN(1)
+}
 
-	// 计算当前应补充的令牌数（按时间流逝比例）
-	now := time.Now()
-	elapsed := now.Sub(l.lastUpdate)
-	tokensToAdd := int(elapsed.Seconds() * float64(l.rate))
-	if tokensToAdd > 0 {
-		l.tokens = min(l.tokens+tokensToAdd, l.capacity)
-		l.lastUpdate = now
+// AllowN 一次消耗 cost 个令牌，用于开销不同的请求；cost 超过桶容量的请求永远不会被放行
+func (l *TokenBucketLimiter) AllowN(cost int) (ratelimit.DoneFunc, error) {
+	if _, _, ok := l.take(cost); !ok {
+		return nil, ErrLimitExceed
 	}
+	return func(ratelimit.DoneInfo) {}, nil
+}
 
-	// 令牌不足时拒绝请求
-	if l.tokens <= 0 {
-		return nil, ErrLimitExceed
+// refill 按距上次更新流逝的时间补充令牌；时钟回拨时不补充
+func (l *TokenBucketLimiter) refill() {
+	now := l.clock.Now()
+	if elapsed := int64(now.Sub(l.lastUpdate)); elapsed > 0 && l.rate > 0 {
+		// 先判断能否装满，避免 elapsed*rate 溢出
+		if elapsed >= (l.capacity-l.tokens)/l.rate+1 {
+			l.tokens = l.capacity
+		} else {
+			l.tokens += elapsed * l.rate
+		}
 	}
+	l.lastUpdate = now
+}
 
-	// 消耗令牌
-	l.tokens--
-	return func(ratelimit.DoneInfo) {}, nil
+// take 消耗 cost 个令牌并返回剩余的整数令牌数；令牌不足时不消耗，返回 false 及凑够 cost 个令牌需要等待的时间
+func (l *TokenBucketLimiter) take(cost int) (remaining int, wait time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	need := int64(cost) * tokenUnit
+	if l.tokens < need {
+		if need > l.capacity {
+			return int(l.tokens / tokenUnit), time.Duration(math.MaxInt64), false
+		}
+		return int(l.tokens / tokenUnit), l.duration(need - l.tokens), false
+	}
+	l.tokens -= need
+	return int(l.tokens / tokenUnit), 0, true
 }
 
-// DoneFunc 空实现（令牌桶算法无需回收令牌）
-func (l *TokenBucketLimiter) Done() {}
+// untilFull 桶重新装满所需的时间
+func (l *TokenBucketLimiter) untilFull() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	return l.duration(l.capacity - l.tokens)
+}
 
-// 工具函数
-func min(a, b int) int {
-	if a < b {
-		return a
+// duration 产生 n 个单位令牌所需的时间，向上取整到纳秒
+func (l *TokenBucketLimiter) duration(n int64) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	if l.rate <= 0 {
+		return time.Duration(math.MaxInt64)
 	}
-	return b
+	return time.Duration((n + l.rate - 1) / l.rate)
 }
 
+// DoneFunc 空实现（令牌桶算法无需回收令牌）
+func (l *TokenBucketLimiter) Done() {}
+
 // 错误定义
 var ErrLimitExceed = errors.New("rate limit exceeded")
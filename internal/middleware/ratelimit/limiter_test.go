@@ -0,0 +1,218 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock 只在调用 Advance 时前进
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1700000000, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// drain 连续取令牌直到被拒绝，返回放行的次数
+func drain(l *TokenBucketLimiter) int {
+	n := 0
+	for {
+		if _, err := l.Allow(); err != nil {
+			return n
+		}
+		n++
+	}
+}
+
+func TestTokenBucketBurst(t *testing.T) {
+	l := NewTokenBucketLimiter(1, 5, WithClock(newFakeClock()))
+	if n := drain(l); n != 5 {
+		t.Fatalf("burst allowed %d requests, want 5", n)
+	}
+	if _, err := l.Allow(); err != ErrLimitExceed {
+		t.Fatalf("Allow on empty bucket = %v, want ErrLimitExceed", err)
+	}
+}
+
+func TestTokenBucketRefill(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(2, 4, WithClock(clock))
+	drain(l)
+
+	tests := []struct {
+		advance time.Duration
+		want    int
+	}{
+		{advance: 250 * time.Millisecond, want: 0},
+		{advance: 250 * time.Millisecond, want: 1}, // 两次各半个令牌累加成一个
+		{advance: 750 * time.Millisecond, want: 1}, // 剩下的半个留到下一次
+		{advance: 250 * time.Millisecond, want: 1},
+		{advance: time.Hour, want: 4}, // 不超过桶容量
+	}
+	for i, tt := range tests {
+		clock.Advance(tt.advance)
+		if n := drain(l); n != tt.want {
+			t.Fatalf("step %d: after %s allowed %d requests, want %d", i, tt.advance, n, tt.want)
+		}
+	}
+}
+
+func TestTokenBucketLowRate(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(1, 2, WithClock(clock))
+	drain(l)
+	// 每次都推进不足一个令牌的时间，令牌仍应按比例累积
+	allowed := 0
+	for i := 0; i < 30; i++ {
+		clock.Advance(300 * time.Millisecond)
+		allowed += drain(l)
+	}
+	if allowed != 9 {
+		t.Fatalf("allowed %d requests in 9s at 1/s, want 9", allowed)
+	}
+}
+
+func TestTokenBucketWeighted(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(1, 5, WithClock(clock))
+
+	if _, err := l.AllowN(3); err != nil {
+		t.Fatalf("AllowN(3) with 5 tokens: %v", err)
+	}
+	if _, err := l.AllowN(3); err != ErrLimitExceed {
+		t.Fatalf("AllowN(3) with 2 tokens = %v, want ErrLimitExceed", err)
+	}
+	// 被拒绝的请求不消耗令牌
+	if _, err := l.AllowN(2); err != nil {
+		t.Fatalf("AllowN(2) with 2 tokens: %v", err)
+	}
+	clock.Advance(time.Hour)
+	if _, err := l.AllowN(6); err != ErrLimitExceed {
+		t.Fatalf("AllowN beyond capacity = %v, want ErrLimitExceed", err)
+	}
+	if _, err := l.AllowN(5); err != nil {
+		t.Fatalf("AllowN(5) on full bucket: %v", err)
+	}
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(4, 2, WithClock(clock))
+	drain(l)
+	clock.Advance(100 * time.Millisecond)
+
+	remaining, wait, ok := l.take(1)
+	if ok || remaining != 0 {
+		t.Fatalf("take on 0.4 tokens = (%d, %v), want rejected", remaining, ok)
+	}
+	if wait != 150*time.Millisecond {
+		t.Fatalf("wait = %s, want 150ms", wait)
+	}
+	if full := l.untilFull(); full != 400*time.Millisecond {
+		t.Fatalf("untilFull = %s, want 400ms", full)
+	}
+	clock.Advance(wait)
+	if _, _, ok := l.take(1); !ok {
+		t.Fatal("take after waiting the reported duration was rejected")
+	}
+}
+
+func TestTokenBucketClockBackwards(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(1, 1, WithClock(clock))
+	drain(l)
+	clock.Advance(-time.Minute)
+	if n := drain(l); n != 0 {
+		t.Fatalf("allowed %d requests after clock moved backwards, want 0", n)
+	}
+	clock.Advance(time.Second)
+	if n := drain(l); n != 1 {
+		t.Fatalf("allowed %d requests one second later, want 1", n)
+	}
+}
+
+func TestTokenBucketConcurrent(t *testing.T) {
+	clock := newFakeClock()
+	l := NewTokenBucketLimiter(10, 100, WithClock(clock))
+
+	var allowed atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := l.Allow(); err == nil {
+					allowed.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := allowed.Load(); n != 100 {
+		t.Fatalf("allowed %d of 500 concurrent requests, want 100", n)
+	}
+
+	// 时钟与取令牌并发时，放行总数不超过初始容量加上补充的令牌
+	allowed.Store(0)
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			clock.Advance(100 * time.Millisecond)
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := l.Allow(); err == nil {
+					allowed.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := allowed.Load() + int64(drain(l)); n != 20 {
+		t.Fatalf("allowed %d requests over 2s at 10/s, want 20", n)
+	}
+}
+
+func TestKeyedLimiterEviction(t *testing.T) {
+	l := NewKeyedLimiter(2)
+	q := Quota{Rate: 1, Burst: 1}
+	ctx := context.Background()
+
+	if !l.Take(ctx, "a", q).Allowed || !l.Take(ctx, "b", q).Allowed {
+		t.Fatal("first request per key should be allowed")
+	}
+	if l.Take(ctx, "a", q).Allowed {
+		t.Fatal("second request for a should be limited")
+	}
+	// a 刚被使用，新建 c 时淘汰 b
+	l.Take(ctx, "c", q)
+	if n := l.Len(); n != 2 {
+		t.Fatalf("Len = %d, want 2", n)
+	}
+	if l.Take(ctx, "a", q).Allowed {
+		t.Fatal("bucket a should have been kept")
+	}
+	if !l.Take(ctx, "b", q).Allowed {
+		t.Fatal("bucket b should have been evicted and recreated full")
+	}
+}
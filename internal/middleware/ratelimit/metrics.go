@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/go-kratos/aegis/ratelimit"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// meter 取自全局 MeterProvider，未配置导出时为空实现
+var meter = otel.Meter("agdemo/internal/middleware/ratelimit")
+
+type instrumented struct {
+	AdaptiveLimiter
+	decisions metric.Int64Counter
+	allowed   metric.AddOption
+	rejected  metric.AddOption
+}
+
+// Instrument 记录每次放行/拒绝（ratelimit.adaptive.decisions），
+// 并以 gauge 导出当前并发数、并发上限和 CPU
+func Instrument(algorithm string, l AdaptiveLimiter) (AdaptiveLimiter, error) {
+	decisions, err := meter.Int64Counter("ratelimit.adaptive.decisions",
+		metric.WithDescription("Requests checked by the adaptive limiter, by decision"))
+	if err != nil {
+		return nil, err
+	}
+	inFlight, err := meter.Int64ObservableGauge("ratelimit.adaptive.in_flight",
+		metric.WithDescription("Requests currently admitted by the adaptive limiter"))
+	if err != nil {
+		return nil, err
+	}
+	limit, err := meter.Int64ObservableGauge("ratelimit.adaptive.limit",
+		metric.WithDescription("Concurrency the adaptive limiter currently allows"))
+	if err != nil {
+		return nil, err
+	}
+	cpu, err := meter.Int64ObservableGauge("ratelimit.adaptive.cpu",
+		metric.WithDescription("CPU usage in per mille as seen by the bbr limiter"))
+	if err != nil {
+		return nil, err
+	}
+	alg := attribute.String("algorithm", algorithm)
+	attrs := metric.WithAttributes(alg)
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		s := l.Stat()
+		o.ObserveInt64(inFlight, s.InFlight, attrs)
+		o.ObserveInt64(limit, s.Limit, attrs)
+		if algorithm == AlgorithmBBR {
+			o.ObserveInt64(cpu, s.CPU, attrs)
+		}
+		return nil
+	}, inFlight, limit, cpu)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumented{
+		AdaptiveLimiter: l,
+		decisions:       decisions,
+		allowed:         metric.WithAttributes(alg, attribute.String("decision", "allowed")),
+		rejected:        metric.WithAttributes(alg, attribute.String("decision", "rejected")),
+	}, nil
+}
+
+func (i *instrumented) Allow() (ratelimit.DoneFunc, error) {
+	done, err := i.AdaptiveLimiter.Allow()
+	if err != nil {
+		i.decisions.Add(context.Background(), 1, i.rejected)
+		return nil, err
+	}
+	i.decisions.Add(context.Background(), 1, i.allowed)
+	return done, nil
+}
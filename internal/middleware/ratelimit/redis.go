@@ -0,0 +1,153 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// redis 限流算法
+const (
+	AlgorithmTokenBucket   = "token_bucket"
+	AlgorithmSlidingWindow = "sliding_window"
+)
+
+const (
+	redisKeyPrefix = "ratelimit:"
+	// redisRetry redis 出错后这段时间内直接使用本地桶，避免每个请求都等待超时
+	redisRetry          = 5 * time.Second
+	defaultRedisTimeout = 100 * time.Millisecond
+)
+
+// 脚本参数统一为 ARGV = {rate, burst, 当前时间微秒, 请求唯一标识}。时间由调用方传入而不是在脚本里
+// 调用 TIME：redis 5 之前脚本执行了非确定性命令后不允许再写入；各副本的时钟偏差会计入配额误差
+
+// tokenBucketScript 补充令牌并取一个，返回 {是否允许, 剩余令牌, 重试等待毫秒, 装满所需毫秒}
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = math.floor(tonumber(ARGV[3]) / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+-- 时钟落后的副本不回拨 ts，避免之后多补令牌
+if now < ts then
+	now = ts
+end
+tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HMSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, math.floor(tokens), wait, math.ceil((burst - tokens) * 1000 / rate)}
+`)
+
+// slidingWindowScript 窗口内最多 burst 次请求，窗口长度 burst/rate 秒；返回值同 tokenBucketScript
+var slidingWindowScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local window = math.ceil(burst * 1000000 / rate)
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+if count < burst then
+	redis.call('ZADD', KEYS[1], now, ARGV[4])
+	redis.call('PEXPIRE', KEYS[1], math.ceil(window / 1000))
+	return {1, burst - count - 1, 0, math.ceil(window / 1000)}
+end
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+local newest = redis.call('ZRANGE', KEYS[1], -1, -1, 'WITHSCORES')
+local wait = math.ceil((tonumber(oldest[2]) + window - now) / 1000)
+local reset = math.ceil((tonumber(newest[2]) + window - now) / 1000)
+return {0, 0, wait, reset}
+`)
+
+// RedisLimiter 限流状态保存在 redis，多个副本共享同一份配额；redis 不可用时退回本地桶
+type RedisLimiter struct {
+	rdb       *redis.Client
+	script    *redis.Script
+	timeout   time.Duration
+	fallback  *KeyedLimiter
+	downUntil atomic.Int64 // unix 纳秒，redis 出错后在此之前不再访问
+	now       func() time.Time
+	log       *log.Helper
+}
+
+func NewRedisLimiter(rdb *redis.Client, algorithm string, timeout time.Duration, fallback *KeyedLimiter, logger log.Logger) (*RedisLimiter, error) {
+	var script *redis.Script
+	switch algorithm {
+	case "", AlgorithmTokenBucket:
+		script = tokenBucketScript
+	case AlgorithmSlidingWindow:
+		script = slidingWindowScript
+	default:
+		return nil, fmt.Errorf("unknown rate limit algorithm %q", algorithm)
+	}
+	if timeout <= 0 {
+		timeout = defaultRedisTimeout
+	}
+	return &RedisLimiter{
+		rdb:      rdb,
+		script:   script,
+		timeout:  timeout,
+		fallback: fallback,
+		now:      time.Now,
+		log:      log.NewHelper(logger),
+	}, nil
+}
+
+// Take 从 key 对应的桶取一个令牌
+func (l *RedisLimiter) Take(ctx context.Context, key string, q Quota) Result {
+	now := l.now()
+	if now.UnixNano() < l.downUntil.Load() {
+		return l.fallback.Take(ctx, key, q)
+	}
+	res, err := l.take(ctx, key, q, now)
+	if err != nil {
+		// 只有第一个发现故障的请求记录日志
+		if l.downUntil.Swap(now.Add(redisRetry).UnixNano()) < now.UnixNano() {
+			l.log.Warnf("redis rate limiter unavailable, using local buckets for %s: %v", redisRetry, err)
+		}
+		return l.fallback.Take(ctx, key, q)
+	}
+	return res
+}
+
+func (l *RedisLimiter) take(ctx context.Context, key string, q Quota, now time.Time) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return Result{}, err
+	}
+	vals, err := l.script.Run(ctx, l.rdb, []string{redisKeyPrefix + key}, q.Rate, q.Burst, now.UnixMicro(), hex.EncodeToString(b)).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	if len(vals) != 4 {
+		return Result{}, fmt.Errorf("unexpected rate limit script reply %v", vals)
+	}
+	return Result{
+		Allowed:    vals[0] == 1,
+		Limit:      q.Burst,
+		Remaining:  int(vals[1]),
+		RetryAfter: time.Duration(vals[2]) * time.Millisecond,
+		Reset:      time.Duration(vals[3]) * time.Millisecond,
+	}, nil
+}
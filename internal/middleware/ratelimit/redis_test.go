@@ -0,0 +1,152 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+func newTestRedisLimiter(t *testing.T, mr *miniredis.Miniredis, algorithm string, clock *fakeClock) *RedisLimiter {
+	t.Helper()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	l, err := NewRedisLimiter(rdb, algorithm, time.Second, NewKeyedLimiter(16), log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.now = clock.Now
+	return l
+}
+
+// takeN 连续取 n 次，返回放行的次数
+func takeN(l *RedisLimiter, key string, q Quota, n int) int {
+	allowed := 0
+	for i := 0; i < n; i++ {
+		if l.Take(context.Background(), key, q).Allowed {
+			allowed++
+		}
+	}
+	return allowed
+}
+
+func TestRedisTokenBucket(t *testing.T) {
+	mr := miniredis.RunT(t)
+	clock := newFakeClock()
+	l := newTestRedisLimiter(t, mr, AlgorithmTokenBucket, clock)
+	q := Quota{Rate: 2, Burst: 4}
+
+	if n := takeN(l, "k", q, 10); n != 4 {
+		t.Fatalf("burst allowed %d requests, want 4", n)
+	}
+	res := l.Take(context.Background(), "k", q)
+	if res.Allowed || res.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("empty bucket = %+v, want denied with RetryAfter 500ms", res)
+	}
+
+	clock.Advance(time.Second)
+	if n := takeN(l, "k", q, 10); n != 2 {
+		t.Fatalf("after 1s allowed %d requests, want 2", n)
+	}
+	// 桶是按 key 隔离的
+	if n := takeN(l, "other", q, 10); n != 4 {
+		t.Fatalf("other key allowed %d requests, want 4", n)
+	}
+}
+
+func TestRedisTokenBucketShared(t *testing.T) {
+	mr := miniredis.RunT(t)
+	clock := newFakeClock()
+	a := newTestRedisLimiter(t, mr, AlgorithmTokenBucket, clock)
+	b := newTestRedisLimiter(t, mr, AlgorithmTokenBucket, clock)
+	q := Quota{Rate: 1, Burst: 4}
+
+	if n := takeN(a, "k", q, 2) + takeN(b, "k", q, 10); n != 4 {
+		t.Fatalf("two replicas allowed %d requests, want 4", n)
+	}
+}
+
+func TestRedisTokenBucketClockSkew(t *testing.T) {
+	mr := miniredis.RunT(t)
+	clock := newFakeClock()
+	ahead := newFakeClock()
+	ahead.Advance(10 * time.Second)
+	a := newTestRedisLimiter(t, mr, AlgorithmTokenBucket, ahead)
+	b := newTestRedisLimiter(t, mr, AlgorithmTokenBucket, clock)
+	q := Quota{Rate: 1, Burst: 2}
+
+	takeN(a, "k", q, 2)
+	// 落后的副本不能把时间戳拨回去，否则时钟快的副本会多补令牌
+	if n := takeN(b, "k", q, 2); n != 0 {
+		t.Fatalf("lagging replica allowed %d requests, want 0", n)
+	}
+	if n := takeN(a, "k", q, 2); n != 0 {
+		t.Fatalf("leading replica allowed %d requests after skew, want 0", n)
+	}
+}
+
+func TestRedisSlidingWindow(t *testing.T) {
+	mr := miniredis.RunT(t)
+	clock := newFakeClock()
+	l := newTestRedisLimiter(t, mr, AlgorithmSlidingWindow, clock)
+	// 窗口 2 秒内最多 4 次
+	q := Quota{Rate: 2, Burst: 4}
+
+	takeN(l, "k", q, 2)
+	clock.Advance(time.Second)
+	if n := takeN(l, "k", q, 10); n != 2 {
+		t.Fatalf("window allowed %d more requests, want 2", n)
+	}
+	// 最早的两次滑出窗口
+	clock.Advance(time.Second + time.Millisecond)
+	if n := takeN(l, "k", q, 10); n != 2 {
+		t.Fatalf("after first requests expired allowed %d, want 2", n)
+	}
+}
+
+func TestRedisFallback(t *testing.T) {
+	mr := miniredis.RunT(t)
+	clock := newFakeClock()
+	l := newTestRedisLimiter(t, mr, AlgorithmTokenBucket, clock)
+	q := Quota{Rate: 1, Burst: 2}
+	key := redisKeyPrefix + "k"
+
+	takeN(l, "k", q, 2)
+	ts := mr.HGet(key, "ts")
+
+	// redis 出错时改用本地桶，本地桶还是满的
+	mr.SetError("LOADING")
+	if n := takeN(l, "k", q, 3); n != 2 {
+		t.Fatalf("fallback allowed %d requests, want 2", n)
+	}
+	if l.fallback.Len() != 1 {
+		t.Fatalf("fallback buckets = %d, want 1", l.fallback.Len())
+	}
+
+	// 恢复后 redisRetry 之内仍不访问 redis
+	mr.SetError("")
+	clock.Advance(redisRetry - time.Second)
+	l.Take(context.Background(), "k", q)
+	if got := mr.HGet(key, "ts"); got != ts {
+		t.Fatalf("redis touched during retry window: ts %s, want %s", got, ts)
+	}
+
+	clock.Advance(time.Second)
+	if !l.Take(context.Background(), "k", q).Allowed {
+		t.Fatal("Take after recovery denied, want refilled redis bucket")
+	}
+	want := strconv.FormatInt(clock.Now().UnixMilli(), 10)
+	if got := mr.HGet(key, "ts"); got != want {
+		t.Fatalf("redis ts after recovery = %s, want %s", got, want)
+	}
+}
+
+func TestRedisUnknownAlgorithm(t *testing.T) {
+	if _, err := NewRedisLimiter(nil, "leaky_bucket", 0, nil, log.DefaultLogger); err == nil {
+		t.Fatal("NewRedisLimiter with unknown algorithm succeeded")
+	}
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	v1 "agdemo/api/blog/v1"
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
+	"google.golang.org/grpc/peer"
+)
+
+func newTestRateLimit(t *testing.T, rc *conf.Server_RateLimit) *RateLimit {
+	t.Helper()
+	r, err := NewRateLimit(&conf.Server{RateLimit: rc}, nil, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+// fromIP 返回来自 ip 的 grpc 请求 ctx
+func fromIP(ctx context.Context, ip string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 40000}})
+}
+
+func TestRateLimitPreAuth(t *testing.T) {
+	r := newTestRateLimit(t, &conf.Server_RateLimit{PreAuth: &conf.Server_RateLimit_Quota{Rate: 1, Burst: 2}})
+	var reached int
+	h := r.PreAuth()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		reached++
+		return nil, nil
+	})
+	// 带不同的 api key 也按 ip 计数
+	for i, key := range []string{"agk_a_x", "agk_b_y"} {
+		ctx := fromIP(serverContext(v1.OperationBlogServiceGetArticle, "x-api-key", key), "10.0.0.1")
+		if _, err := h(ctx, nil); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+	ctx := fromIP(serverContext(v1.OperationUserServiceGetMe, "x-api-key", "agk_c_z"), "10.0.0.1")
+	if _, err := h(ctx, nil); err != ratelimit.ErrLimitExceed {
+		t.Fatalf("third request err = %v, want limit exceeded", err)
+	}
+	if reached != 2 {
+		t.Fatalf("handler reached %d times, want 2", reached)
+	}
+	if _, err := h(fromIP(serverContext(v1.OperationUserServiceGetMe), "10.0.0.2"), nil); err != nil {
+		t.Fatalf("other ip: %v", err)
+	}
+
+	// 未配置时不限流
+	h = newTestRateLimit(t, &conf.Server_RateLimit{}).PreAuth()(callerHandler)
+	for i := 0; i < 10; i++ {
+		if _, err := h(fromIP(serverContext(v1.OperationUserServiceGetMe), "10.0.0.1"), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRateLimitBucketKey(t *testing.T) {
+	r := newTestRateLimit(t, &conf.Server_RateLimit{})
+	anon := fromIP(context.Background(), "10.0.0.1")
+	user := biz.NewCallerContext(anon, &biz.Caller{UserId: 3})
+	key := biz.NewCallerContext(anon, &biz.Caller{UserId: 3, ApiKeyId: 9})
+	tests := []struct {
+		ctx  context.Context
+		kind string
+		want string
+	}{
+		{anon, rateKeyCaller, "ip:10.0.0.1"},
+		{user, rateKeyCaller, "user:3"},
+		{key, rateKeyCaller, "key:9"},
+		{user, rateKeyApiKey, "ip:10.0.0.1"},
+		{key, rateKeyUser, "user:3"},
+		{key, rateKeyIP, "ip:10.0.0.1"},
+		{key, rateKeyOperation, "op:/op"},
+	}
+	for _, tt := range tests {
+		if got := r.bucketKey(tt.ctx, tt.kind, "/op"); got != tt.want {
+			t.Errorf("bucketKey(%s) = %q, want %q", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestNewRateLimitConfig(t *testing.T) {
+	for name, rc := range map[string]*conf.Server_RateLimit{
+		"pre_auth without rate":  {PreAuth: &conf.Server_RateLimit_Quota{Burst: 1}},
+		"rule without operation": {Rules: []*conf.Server_RateLimit_Rule{{Quota: &conf.Server_RateLimit_Quota{Rate: 1}}}},
+		"unknown key":            {Rules: []*conf.Server_RateLimit_Rule{{Operation: "/op", Key: "tenant", Quota: &conf.Server_RateLimit_Quota{Rate: 1}}}},
+		"unknown backend":        {Backend: "memcached"},
+	} {
+		if _, err := NewRateLimit(&conf.Server{RateLimit: rc}, nil, log.DefaultLogger); err == nil {
+			t.Errorf("%s: NewRateLimit should fail", name)
+		}
+	}
+}
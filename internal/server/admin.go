@@ -0,0 +1,43 @@
+package server
+
+import (
+	"agdemo/internal/conf"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// AdminServer 单独监听内部地址导出 /metrics，不经过业务中间件，也不对外暴露；未配置地址时不启动
+type AdminServer struct {
+	srv *http.Server
+}
+
+// NewAdminServer new an admin HTTP server.
+func NewAdminServer(c *conf.Server) *AdminServer {
+	ac := c.GetAdmin()
+	if ac.GetAddr() == "" {
+		return &AdminServer{}
+	}
+	opts := []http.ServerOption{http.Address(ac.Addr)}
+	if ac.Network != "" {
+		opts = append(opts, http.Network(ac.Network))
+	}
+	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", promhttp.Handler())
+	return &AdminServer{srv: srv}
+}
+
+func (s *AdminServer) Start(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Start(ctx)
+}
+
+func (s *AdminServer) Stop(ctx context.Context) error {
+	if s.srv == nil {
+		return nil
+	}
+	return s.srv.Stop(ctx)
+}
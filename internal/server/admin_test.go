@@ -0,0 +1,37 @@
+package server
+
+import (
+	"agdemo/internal/conf"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAdminServerMetrics(t *testing.T) {
+	s := NewAdminServer(&conf.Server{Admin: &conf.Server_Admin{Addr: "127.0.0.1:0"}})
+	rec := httptest.NewRecorder()
+	s.srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /metrics = %d, want 200", rec.Code)
+	}
+	// 只导出指标，不挂业务接口
+	rec = httptest.NewRecorder()
+	s.srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/articles", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("GET /v1/articles = %d, want 404", rec.Code)
+	}
+}
+
+func TestAdminServerDisabled(t *testing.T) {
+	s := NewAdminServer(&conf.Server{})
+	if s.srv != nil {
+		t.Fatal("admin server created without an address")
+	}
+	if err := s.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Stop(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,10 +4,8 @@ import (
 	v1 "agdemo/api/blog/v1"
 	"agdemo/internal/conf"
 	"agdemo/internal/middleware"
-	myRatelimit "agdemo/internal/middleware/ratelimit"
 	"agdemo/internal/service"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
-	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"go.opentelemetry.io/otel"
@@ -15,10 +13,12 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, blog *service.BlogService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, blog *service.BlogService, comment *service.CommentService, user *service.UserService, apiKey *service.ApiKeyService, health *service.HealthService,
+	keyAuth *middleware.APIKey, jwt *middleware.JWT, authz *middleware.Authz, limit *middleware.RateLimit, adaptive *middleware.Adaptive, metrics *middleware.Metrics, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
@@ -27,9 +27,18 @@ func NewGRPCServer(c *conf.Server, blog *service.BlogService, logger log.Logger)
 			tracing.Server(
 				tracing.WithTracerProvider(otel.GetTracerProvider()),
 			),
+			metrics.Server(),
+			adaptive.Server(),
+			limit.PreAuth(),
+			keyAuth.Server(),
+			jwt.Server(),
+			authz.Server(),
+			limit.Server(),
 			validate.Validator(),
-			ratelimit.Server(ratelimit.WithLimiter(myRatelimit.NewTokenBucketLimiter(1, 5))),
+			middleware.ETag(),
 		),
+		// grpc.health.v1 由 HealthService 提供，反映数据库与 redis 的状态
+		grpc.CustomHealth(),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -42,5 +51,9 @@ func NewGRPCServer(c *conf.Server, blog *service.BlogService, logger log.Logger)
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterBlogServiceServer(srv, blog)
+	v1.RegisterCommentServiceServer(srv, comment)
+	v1.RegisterUserServiceServer(srv, user)
+	v1.RegisterApiKeyServiceServer(srv, apiKey)
+	grpc_health_v1.RegisterHealthServer(srv, health)
 	return srv
 }
@@ -4,11 +4,9 @@ import (
 	v1 "agdemo/api/blog/v1"
 	"agdemo/internal/conf"
 	"agdemo/internal/middleware"
-	myRatelimit "agdemo/internal/middleware/ratelimit"
 	"agdemo/internal/service"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
-	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
@@ -17,7 +15,8 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, blog *service.BlogService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, blog *service.BlogService, comment *service.CommentService, user *service.UserService, apiKey *service.ApiKeyService, health *service.HealthService,
+	keyAuth *middleware.APIKey, jwt *middleware.JWT, authz *middleware.Authz, limit *middleware.RateLimit, adaptive *middleware.Adaptive, metrics *middleware.Metrics, logger log.Logger) *http.Server {
 
 	var opts = []http.ServerOption{
 		http.Middleware(
@@ -27,8 +26,15 @@ func NewHTTPServer(c *conf.Server, blog *service.BlogService, logger log.Logger)
 			tracing.Server(
 				tracing.WithTracerProvider(otel.GetTracerProvider()),
 			),
+			metrics.Server(),
+			adaptive.Server(),
+			limit.PreAuth(),
+			keyAuth.Server(),
+			jwt.Server(),
+			authz.Server(),
+			limit.Server(),
 			validate.Validator(),
-			ratelimit.Server(ratelimit.WithLimiter(myRatelimit.NewTokenBucketLimiter(1, 5))),
+			middleware.ETag(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -41,6 +47,11 @@ func NewHTTPServer(c *conf.Server, blog *service.BlogService, logger log.Logger)
 		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := http.NewServer(opts...)
+	srv.HandleFunc("/healthz", health.Liveness)
+	srv.HandleFunc("/readyz", health.Readiness)
 	v1.RegisterBlogServiceHTTPServer(srv, blog)
+	v1.RegisterCommentServiceHTTPServer(srv, comment)
+	v1.RegisterUserServiceHTTPServer(srv, user)
+	v1.RegisterApiKeyServiceHTTPServer(srv, apiKey)
 	return srv
 }
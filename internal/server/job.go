@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// job 按固定间隔执行 run 的后台任务，实现 transport.Server 以便随 kratos 应用启停
+type job struct {
+	interval time.Duration
+	run      func(ctx context.Context)
+
+	started  atomic.Bool
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func newJob(interval time.Duration, run func(ctx context.Context)) *job {
+	return &job{
+		interval: interval,
+		run:      run,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start 阻塞运行直到 Stop 被调用，只能调用一次
+func (j *job) Start(ctx context.Context) error {
+	if !j.started.CompareAndSwap(false, true) {
+		return errors.New("job already started")
+	}
+	defer close(j.done)
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.stop:
+			return nil
+		case <-ticker.C:
+			j.run(ctx)
+		}
+	}
+}
+
+// Stop 停止调度并等待正在执行的一轮结束，可重复调用；未启动时直接返回
+func (j *job) Stop(ctx context.Context) error {
+	j.stopOnce.Do(func() { close(j.stop) })
+	if !j.started.Load() {
+		return nil
+	}
+	select {
+	case <-j.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
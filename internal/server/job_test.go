@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestJobStopWithoutStart(t *testing.T) {
+	j := newJob(time.Hour, func(context.Context) {})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := j.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJobStopTwice(t *testing.T) {
+	var runs atomic.Int32
+	j := newJob(time.Millisecond, func(context.Context) { runs.Add(1) })
+	started := make(chan error, 1)
+	go func() { started <- j.Start(context.Background()) }()
+	for runs.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := j.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-started; err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Start(context.Background()); err == nil {
+		t.Fatal("restarting a job should fail")
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultLikeFlushInterval = 5 * time.Second
+	defaultLikeFlushBatch    = 100
+)
+
+// LikeFlusher 定期把 redis 中变化的点赞数和浏览数回写到 article.like_count、view_count，
+// 作为 kratos.Server 随应用启停，停止时做最后一次完整回写
+type LikeFlusher struct {
+	*job
+	article *biz.ArticleUsecase
+	batch   int
+	log     *log.Helper
+}
+
+// NewLikeFlusher new a like counter flusher.
+func NewLikeFlusher(c *conf.Data, article *biz.ArticleUsecase, logger log.Logger) *LikeFlusher {
+	interval := defaultLikeFlushInterval
+	f := &LikeFlusher{
+		article: article,
+		batch:   defaultLikeFlushBatch,
+		log:     log.NewHelper(logger),
+	}
+	if c.LikeFlush != nil {
+		if c.LikeFlush.Interval != nil && c.LikeFlush.Interval.AsDuration() > 0 {
+			interval = c.LikeFlush.Interval.AsDuration()
+		}
+		if c.LikeFlush.BatchSize > 0 {
+			f.batch = int(c.LikeFlush.BatchSize)
+		}
+	}
+	f.job = newJob(interval, f.flush)
+	return f
+}
+
+// Stop 停止定时回写并做最后一次回写
+func (f *LikeFlusher) Stop(ctx context.Context) error {
+	if err := f.job.Stop(ctx); err != nil {
+		return err
+	}
+	f.flush(ctx)
+	return nil
+}
+
+func (f *LikeFlusher) flush(ctx context.Context) {
+	n, err := f.article.FlushLikes(ctx, f.batch)
+	if err != nil {
+		f.log.Errorf("flush like counters error: %v", err)
+	}
+	if n > 0 {
+		f.log.Infof("flushed %d like counters", n)
+	}
+	n, err = f.article.FlushViews(ctx, f.batch)
+	if err != nil {
+		f.log.Errorf("flush view counters error: %v", err)
+	}
+	if n > 0 {
+		f.log.Infof("flushed %d view counters", n)
+	}
+}
@@ -0,0 +1,43 @@
+// server/meter.go
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	metricsdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.16.0"
+)
+
+// InitMeter 初始化 OpenTelemetry Meter，指标注册到 Prometheus 默认 registry，由 AdminServer 的 /metrics 导出
+func InitMeter(serviceName string) (func(), error) {
+	exporter, err := prometheus.New()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(semconv.ServiceNameKey.String(serviceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mp := metricsdk.NewMeterProvider(
+		metricsdk.WithReader(exporter),
+		metricsdk.WithResource(res),
+	)
+	// 在此之前创建的指标会转发到新的 provider
+	otel.SetMeterProvider(mp)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		if err := mp.Shutdown(ctx); err != nil {
+			otel.Handle(err)
+		}
+	}, nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultPublishInterval = 10 * time.Second
+	defaultPublishBatch    = 100
+	defaultPublishLockTTL  = 30 * time.Second
+)
+
+// PublishScheduler 定期发布到期的定时文章，多副本部署时由 redis 锁保证同一时刻只有一个副本执行
+type PublishScheduler struct {
+	*job
+	article *biz.ArticleUsecase
+	batch   int
+	lockTTL time.Duration
+	log     *log.Helper
+}
+
+// NewPublishScheduler new a scheduled publishing job.
+func NewPublishScheduler(c *conf.Data, article *biz.ArticleUsecase, logger log.Logger) *PublishScheduler {
+	interval := defaultPublishInterval
+	s := &PublishScheduler{
+		article: article,
+		batch:   defaultPublishBatch,
+		lockTTL: defaultPublishLockTTL,
+		log:     log.NewHelper(logger),
+	}
+	if c.Publish != nil {
+		if c.Publish.Interval != nil && c.Publish.Interval.AsDuration() > 0 {
+			interval = c.Publish.Interval.AsDuration()
+		}
+		if c.Publish.BatchSize > 0 {
+			s.batch = int(c.Publish.BatchSize)
+		}
+		if c.Publish.LockTtl != nil && c.Publish.LockTtl.AsDuration() > 0 {
+			s.lockTTL = c.Publish.LockTtl.AsDuration()
+		}
+	}
+	s.job = newJob(interval, s.publish)
+	return s
+}
+
+func (s *PublishScheduler) publish(ctx context.Context) {
+	n, err := s.article.PublishDue(ctx, s.batch, s.lockTTL)
+	if err != nil {
+		s.log.Errorf("publish scheduled articles error: %v", err)
+	}
+	if n > 0 {
+		s.log.Infof("published %d scheduled articles", n)
+	}
+}
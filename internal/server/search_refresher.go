@@ -0,0 +1,36 @@
+package server
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultSearchRefreshInterval = time.Minute
+
+// SearchRefresher 定期让本副本的搜索索引追上其他副本的写入，每个副本各自执行
+type SearchRefresher struct {
+	*job
+	article *biz.ArticleUsecase
+	log     *log.Helper
+}
+
+// NewSearchRefresher new a search index refresh job.
+func NewSearchRefresher(c *conf.Data, article *biz.ArticleUsecase, logger log.Logger) *SearchRefresher {
+	interval := defaultSearchRefreshInterval
+	if d := c.GetSearch().GetRefreshInterval(); d != nil && d.AsDuration() > 0 {
+		interval = d.AsDuration()
+	}
+	r := &SearchRefresher{article: article, log: log.NewHelper(logger)}
+	r.job = newJob(interval, r.refresh)
+	return r
+}
+
+func (r *SearchRefresher) refresh(ctx context.Context) {
+	if err := r.article.RefreshSearchIndex(ctx); err != nil {
+		r.log.Errorf("refresh search index error: %v", err)
+	}
+}
@@ -1,8 +1,12 @@
 package server
 
 import (
+	"agdemo/internal/biz"
+	"agdemo/internal/middleware"
+
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewAdminServer, NewLikeFlusher, NewTrashPurger, NewPublishScheduler, NewSearchRefresher,
+	middleware.NewAPIKey, middleware.NewJWT, middleware.NewAuthz, middleware.NewRateLimit, middleware.NewAdaptive, middleware.NewMetrics, wire.Bind(new(biz.TokenIssuer), new(*middleware.JWT)))
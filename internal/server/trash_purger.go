@@ -0,0 +1,65 @@
+package server
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultTrashRetention = 30 * 24 * time.Hour
+	defaultTrashInterval  = time.Hour
+	defaultTrashBatch     = 100
+	defaultTrashLockTTL   = 5 * time.Minute
+)
+
+// TrashPurger 定期彻底删除在回收站中超过保留期的文章，多副本部署时由 redis 锁保证同一时刻只有一个副本执行
+type TrashPurger struct {
+	*job
+	article   *biz.ArticleUsecase
+	retention time.Duration
+	batch     int
+	lockTTL   time.Duration
+	log       *log.Helper
+}
+
+// NewTrashPurger new a trash purger.
+func NewTrashPurger(c *conf.Data, article *biz.ArticleUsecase, logger log.Logger) *TrashPurger {
+	interval := defaultTrashInterval
+	p := &TrashPurger{
+		article:   article,
+		retention: defaultTrashRetention,
+		batch:     defaultTrashBatch,
+		lockTTL:   defaultTrashLockTTL,
+		log:       log.NewHelper(logger),
+	}
+	if c.Trash != nil {
+		if c.Trash.Retention != nil && c.Trash.Retention.AsDuration() > 0 {
+			p.retention = c.Trash.Retention.AsDuration()
+		}
+		if c.Trash.Interval != nil && c.Trash.Interval.AsDuration() > 0 {
+			interval = c.Trash.Interval.AsDuration()
+		}
+		if c.Trash.BatchSize > 0 {
+			p.batch = int(c.Trash.BatchSize)
+		}
+		if c.Trash.LockTtl != nil && c.Trash.LockTtl.AsDuration() > 0 {
+			p.lockTTL = c.Trash.LockTtl.AsDuration()
+		}
+	}
+	p.job = newJob(interval, p.purge)
+	return p
+}
+
+func (p *TrashPurger) purge(ctx context.Context) {
+	n, err := p.article.PurgeTrash(ctx, time.Now().Add(-p.retention), p.batch, p.lockTTL)
+	if err != nil {
+		p.log.Errorf("purge trash error: %v", err)
+	}
+	if n > 0 {
+		p.log.Infof("purged %d articles from the trash", n)
+	}
+}
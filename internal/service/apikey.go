@@ -0,0 +1,50 @@
+package service
+
+import (
+	"agdemo/internal/biz"
+	"context"
+
+	pb "agdemo/api/blog/v1"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type ApiKeyService struct {
+	pb.UnimplementedApiKeyServiceServer
+
+	keys *biz.ApiKeyUsecase
+
+	log *log.Helper
+}
+
+func NewApiKeyService(keys *biz.ApiKeyUsecase, logger log.Logger) *ApiKeyService {
+	return &ApiKeyService{keys: keys, log: log.NewHelper(logger)}
+}
+
+func (s *ApiKeyService) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyReply, error) {
+	k := &biz.ApiKey{Name: req.Name, Scopes: req.Scopes}
+	key, err := s.keys.Create(ctx, k, req.Ttl.AsDuration())
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.CreateApiKeyReply{ApiKey: k.ToProto(), Key: key}, nil
+}
+
+func (s *ApiKeyService) ListApiKeys(ctx context.Context, req *pb.ListApiKeysRequest) (*pb.ListApiKeysReply, error) {
+	page, err := s.keys.List(ctx, int(req.PageSize), req.PageToken, req.IncludeRevoked)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	reply := &pb.ListApiKeysReply{NextPageToken: page.NextPageToken}
+	for _, k := range page.Keys {
+		reply.Results = append(reply.Results, k.ToProto())
+	}
+	return reply, nil
+}
+
+func (s *ApiKeyService) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyReply, error) {
+	k, err := s.keys.Revoke(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.RevokeApiKeyReply{ApiKey: k.ToProto()}, nil
+}
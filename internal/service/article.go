@@ -2,23 +2,22 @@ package service
 
 import (
 	"agdemo/internal/biz"
-	"agdemo/internal/code"
 	"context"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otel_codes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	pb "agdemo/api/blog/v1"
 )
 
-func NewBlogService(article *biz.ArticleUsecase, logger log.Logger) *BlogService {
+func NewBlogService(article *biz.ArticleUsecase, taxonomy *biz.TaxonomyUsecase, logger log.Logger) *BlogService {
 	return &BlogService{
-		article: article,
-		log:     log.NewHelper(logger),
+		article:  article,
+		taxonomy: taxonomy,
+		log:      log.NewHelper(logger),
 	}
 }
 
@@ -45,8 +44,10 @@ func (s *BlogService) CreateArticle(ctx context.Context, req *pb.CreateArticleRe
 
 	// 2. 创建并校验领域对象
 	article := &biz.Article{
-		Title:   req.Title,
-		Content: req.Content,
+		Title:      req.Title,
+		Content:    req.Content,
+		Tags:       req.Tags,
+		CategoryId: req.CategoryId,
 	}
 
 	// 3. 执行业务逻辑
@@ -54,7 +55,7 @@ func (s *BlogService) CreateArticle(ctx context.Context, req *pb.CreateArticleRe
 		// 记录错误
 		span.RecordError(err)
 		span.SetStatus(otel_codes.Error, err.Error())
-		return nil, status.Error(codes.Internal, "创建文章失败")
+		return nil, s.toStatus(ctx, err)
 	}
 
 	//span.AddEvent("业务逻辑处理完成",
@@ -71,45 +72,211 @@ func (s *BlogService) CreateArticle(ctx context.Context, req *pb.CreateArticleRe
 func (s *BlogService) UpdateArticle(ctx context.Context, req *pb.UpdateArticleRequest) (*pb.UpdateArticleReply, error) {
 	s.log.Infof("input data %v", req)
 	article := biz.Article{
-		Title:   req.Title,
-		Content: req.Content,
+		Title:      req.Title,
+		Content:    req.Content,
+		Version:    req.Version,
+		Tags:       req.Tags,
+		CategoryId: req.CategoryId,
 	}
 
-	err := s.article.Update(ctx, req.Id, &article)
-	return &pb.UpdateArticleReply{}, err
+	updated, err := s.article.Update(ctx, req.Id, &article, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.UpdateArticleReply{Article: updated.ToProto()}, nil
 }
 
 func (s *BlogService) DeleteArticle(ctx context.Context, req *pb.DeleteArticleRequest) (*pb.DeleteArticleReply, error) {
 	s.log.Infof("input data %v", req)
-	err := s.article.Delete(ctx, req.Id)
-	return &pb.DeleteArticleReply{}, err
+	if req.Id < 1 {
+		return nil, biz.ErrInvalidId
+	}
+	if err := s.article.Delete(ctx, req.Id, req.Purge); err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.DeleteArticleReply{}, nil
 }
 
 func (s *BlogService) GetArticle(ctx context.Context, req *pb.GetArticleRequest) (*pb.GetArticleReply, error) {
 	if req.Id < 1 {
-		return nil, code.InvalidId
+		return nil, biz.ErrInvalidId
 	}
 	tr := otel.Tracer("api")
 	ctx, span := tr.Start(ctx, "GetArticle")
 	defer span.End()
 	p, err := s.article.Get(ctx, req.Id)
 	if err != nil {
-		return nil, err
+		return nil, s.toStatus(ctx, err)
 	}
-	return &pb.GetArticleReply{Article: &pb.Article{Id: p.Id, Title: p.Title, Content: p.Content, Like: p.Like}}, nil
+	return &pb.GetArticleReply{Article: p.ToProto()}, nil
 }
 
-func (s *BlogService) ListArticle(ctx context.Context, req *pb.ListArticleRequest) (*pb.ListArticleReply, error) {
-	ps, err := s.article.List(ctx)
-	reply := &pb.ListArticleReply{}
-	for _, p := range ps {
-		reply.Results = append(reply.Results, &pb.Article{
-			Id:      p.Id,
-			Title:   p.Title,
-			Content: p.Content,
+func (s *BlogService) SearchArticles(ctx context.Context, req *pb.SearchArticlesRequest) (*pb.SearchArticlesReply, error) {
+	page, err := s.article.Search(ctx, req.Query, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	reply := &pb.SearchArticlesReply{NextPageToken: page.NextPageToken}
+	for _, r := range page.Results {
+		reply.Results = append(reply.Results, &pb.SearchHit{
+			Article:        r.Article.ToProto(),
+			Score:          r.Score,
+			TitleSnippet:   r.TitleSnippet,
+			ContentSnippet: r.ContentSnippet,
 		})
 	}
-	return reply, err
+	return reply, nil
+}
+
+func (s *BlogService) ListDeletedArticles(ctx context.Context, req *pb.ListDeletedArticlesRequest) (*pb.ListDeletedArticlesReply, error) {
+	page, err := s.article.List(ctx, &biz.ListArticleQuery{
+		ArticleFilter: biz.ArticleFilter{Deleted: true},
+		PageSize:      int(req.PageSize),
+		PageToken:     req.PageToken,
+	})
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	reply := &pb.ListDeletedArticlesReply{NextPageToken: page.NextPageToken}
+	for _, p := range page.Articles {
+		reply.Results = append(reply.Results, p.ToProto())
+	}
+	return reply, nil
+}
+
+func (s *BlogService) RestoreArticle(ctx context.Context, req *pb.RestoreArticleRequest) (*pb.RestoreArticleReply, error) {
+	p, err := s.article.Restore(ctx, req.Id)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.RestoreArticleReply{Article: p.ToProto()}, nil
+}
+
+func (s *BlogService) SubmitArticle(ctx context.Context, req *pb.SubmitArticleRequest) (*pb.SubmitArticleReply, error) {
+	p, err := s.article.Submit(ctx, req.Id)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.SubmitArticleReply{Article: p.ToProto()}, nil
+}
+
+func (s *BlogService) ScheduleArticle(ctx context.Context, req *pb.ScheduleArticleRequest) (*pb.ScheduleArticleReply, error) {
+	p, err := s.article.Schedule(ctx, req.Id, req.PublishAt.AsTime())
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.ScheduleArticleReply{Article: p.ToProto()}, nil
+}
+
+func (s *BlogService) PublishArticle(ctx context.Context, req *pb.PublishArticleRequest) (*pb.PublishArticleReply, error) {
+	p, err := s.article.Publish(ctx, req.Id)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.PublishArticleReply{Article: p.ToProto()}, nil
+}
+
+func (s *BlogService) UnpublishArticle(ctx context.Context, req *pb.UnpublishArticleRequest) (*pb.UnpublishArticleReply, error) {
+	p, err := s.article.Unpublish(ctx, req.Id)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.UnpublishArticleReply{Article: p.ToProto()}, nil
+}
+
+func (s *BlogService) ArchiveArticle(ctx context.Context, req *pb.ArchiveArticleRequest) (*pb.ArchiveArticleReply, error) {
+	p, err := s.article.Archive(ctx, req.Id)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.ArchiveArticleReply{Article: p.ToProto()}, nil
+}
+
+func (s *BlogService) ListArticleRevisions(ctx context.Context, req *pb.ListArticleRevisionsRequest) (*pb.ListArticleRevisionsReply, error) {
+	page, err := s.article.ListRevisions(ctx, req.Id, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	reply := &pb.ListArticleRevisionsReply{NextPageToken: page.NextPageToken}
+	for _, r := range page.Revisions {
+		reply.Results = append(reply.Results, r.ToProto())
+	}
+	return reply, nil
+}
+
+func (s *BlogService) GetArticleRevision(ctx context.Context, req *pb.GetArticleRevisionRequest) (*pb.GetArticleRevisionReply, error) {
+	r, err := s.article.GetRevision(ctx, req.Id, req.Version)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.GetArticleRevisionReply{Revision: r.ToProto()}, nil
+}
+
+func (s *BlogService) DiffArticleRevisions(ctx context.Context, req *pb.DiffArticleRevisionsRequest) (*pb.DiffArticleRevisionsReply, error) {
+	diff, err := s.article.DiffRevisions(ctx, req.Id, req.FromVersion, req.ToVersion)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.DiffArticleRevisionsReply{Diff: diff}, nil
+}
+
+func (s *BlogService) RollbackArticle(ctx context.Context, req *pb.RollbackArticleRequest) (*pb.RollbackArticleReply, error) {
+	s.log.Infof("input data %v", req)
+	p, err := s.article.Rollback(ctx, req.Id, req.ToVersion, req.Version)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.RollbackArticleReply{Article: p.ToProto()}, nil
+}
+
+func (s *BlogService) LikeArticle(ctx context.Context, req *pb.LikeArticleRequest) (*pb.LikeArticleReply, error) {
+	n, err := s.article.Like(ctx, req.Id)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.LikeArticleReply{LikeCount: n}, nil
+}
+
+func (s *BlogService) UnlikeArticle(ctx context.Context, req *pb.UnlikeArticleRequest) (*pb.UnlikeArticleReply, error) {
+	n, err := s.article.Unlike(ctx, req.Id)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.UnlikeArticleReply{LikeCount: n}, nil
+}
+
+func (s *BlogService) ListArticle(ctx context.Context, req *pb.ListArticleRequest) (*pb.ListArticleReply, error) {
+	q := &biz.ListArticleQuery{
+		ArticleFilter: biz.ArticleFilter{
+			Title:      req.Title,
+			Tag:        req.Tag,
+			CategoryId: req.CategoryId,
+			AuthorId:   req.AuthorId,
+		},
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+		OrderBy:   req.OrderBy,
+		WithTotal: req.WithTotal,
+	}
+	q.Status = biz.ArticleStatusFromProto(req.Status)
+	if req.CreatedAfter != nil {
+		q.CreatedAfter = req.CreatedAfter.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		q.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+	page, err := s.article.List(ctx, q)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	reply := &pb.ListArticleReply{
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.Total,
+	}
+	for _, p := range page.Articles {
+		reply.Results = append(reply.Results, p.ToProto())
+	}
+	return reply, nil
 }
 
 func (s *BlogService) ArticleCastJson(ctx context.Context, req *pb.ArticleCastJsonRequest) (*pb.ArticleCastJsonReply, error) {
@@ -120,7 +287,22 @@ func (s *BlogService) ArticleCastJson(ctx context.Context, req *pb.ArticleCastJs
 	}
 	json, err := s.article.CastJson(ctx, article)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "转换文章json失败")
+		return nil, pb.ErrorBlogInternal("转换文章json失败")
 	}
 	return &pb.ArticleCastJsonReply{Json: json}, nil
 }
+
+// toStatus 统一错误出口：业务错误原样返回（kratos 按 HTTP code 映射 gRPC code），
+// 未识别的错误只记录日志，对外返回不含细节的 500
+func (s *BlogService) toStatus(ctx context.Context, err error) error {
+	return toStatus(ctx, s.log, err)
+}
+
+func toStatus(ctx context.Context, l *log.Helper, err error) error {
+	var se *errors.Error
+	if errors.As(err, &se) {
+		return se
+	}
+	l.WithContext(ctx).Errorf("unexpected error: %v", err)
+	return pb.ErrorBlogInternal("internal error")
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"agdemo/internal/biz"
+	"context"
+
+	pb "agdemo/api/blog/v1"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type CommentService struct {
+	pb.UnimplementedCommentServiceServer
+
+	comment *biz.CommentUsecase
+
+	log *log.Helper
+}
+
+func NewCommentService(comment *biz.CommentUsecase, logger log.Logger) *CommentService {
+	return &CommentService{comment: comment, log: log.NewHelper(logger)}
+}
+
+func toCommentsProto(list []*biz.Comment) []*pb.Comment {
+	res := make([]*pb.Comment, 0, len(list))
+	for _, c := range list {
+		res = append(res, c.ToProto())
+	}
+	return res
+}
+
+func (s *CommentService) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentReply, error) {
+	c := &biz.Comment{
+		ArticleId: req.ArticleId,
+		ParentId:  req.ParentId,
+		Author:    req.Author,
+		Content:   req.Content,
+	}
+	if err := s.comment.Create(ctx, c); err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.CreateCommentReply{Comment: c.ToProto()}, nil
+}
+
+func (s *CommentService) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsReply, error) {
+	page, err := s.comment.List(ctx, req.ArticleId, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.ListCommentsReply{
+		Results:       toCommentsProto(page.Comments),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *CommentService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentReply, error) {
+	if err := s.comment.Delete(ctx, req.Id); err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.DeleteCommentReply{}, nil
+}
+
+func (s *CommentService) ListPendingComments(ctx context.Context, req *pb.ListPendingCommentsRequest) (*pb.ListPendingCommentsReply, error) {
+	page, err := s.comment.ListPending(ctx, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.ListPendingCommentsReply{
+		Results:       toCommentsProto(page.Comments),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *CommentService) ModerateComment(ctx context.Context, req *pb.ModerateCommentRequest) (*pb.ModerateCommentReply, error) {
+	c, err := s.comment.Moderate(ctx, req.Id, biz.CommentStatusFromProto(req.Status))
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.ModerateCommentReply{Comment: c.ToProto()}, nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"agdemo/internal/biz"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	pb "agdemo/api/blog/v1"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// watchInterval Watch 重新检查依赖的间隔，所有 Watch 共用同一份检查结果，状态变化时才推送
+	watchInterval = 5 * time.Second
+	// probeMaxAge /readyz 与 Check 无需认证，窗口内的请求共用一次检查，不会把每个探测都放大到数据库和 redis
+	probeMaxAge = time.Second
+)
+
+// HealthService 提供 HTTP /healthz、/readyz 与 grpc.health.v1，空服务名与本进程注册的服务共用同一个就绪状态
+type HealthService struct {
+	healthpb.UnimplementedHealthServer
+
+	health   *biz.HealthUsecase
+	services map[string]bool
+
+	log *log.Helper
+}
+
+func NewHealthService(health *biz.HealthUsecase, logger log.Logger) *HealthService {
+	services := map[string]bool{"": true}
+	for _, name := range []string{
+		pb.BlogService_ServiceDesc.ServiceName,
+		pb.CommentService_ServiceDesc.ServiceName,
+		pb.UserService_ServiceDesc.ServiceName,
+		pb.ApiKeyService_ServiceDesc.ServiceName,
+	} {
+		services[name] = true
+	}
+	return &HealthService{health: health, services: services, log: log.NewHelper(logger)}
+}
+
+// Drain 关闭前调用，之后所有就绪检查都返回未就绪
+func (s *HealthService) Drain(ctx context.Context) error {
+	return s.health.Drain(ctx)
+}
+
+type dependencyJSON struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+}
+
+type readinessJSON struct {
+	Status       string                     `json:"status"`
+	Dependencies map[string]*dependencyJSON `json:"dependencies,omitempty"`
+}
+
+// Liveness 进程能处理请求即为存活，不检查外部依赖，避免依赖故障时被反复重启
+func (s *HealthService) Liveness(w http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(w, http.StatusOK, &readinessJSON{Status: "ok"})
+}
+
+// Readiness 所有依赖可用时返回 200，否则返回 503，并附带每个依赖的状态；
+// 错误详情可能含有地址与账号，只记录日志不返回
+func (s *HealthService) Readiness(w http.ResponseWriter, r *http.Request) {
+	res := s.health.ReadyCached(r.Context(), probeMaxAge)
+	body := &readinessJSON{Status: "ready"}
+	switch {
+	case res.Draining:
+		body.Status = "draining"
+	case !res.Ready:
+		body.Status = "not_ready"
+	}
+	if len(res.Dependencies) > 0 {
+		body.Dependencies = make(map[string]*dependencyJSON, len(res.Dependencies))
+	}
+	for _, d := range res.Dependencies {
+		dj := &dependencyJSON{Status: "up", LatencyMs: float64(d.Latency.Microseconds()) / 1000}
+		if d.Err != nil {
+			dj.Status = "down"
+		}
+		body.Dependencies[d.Name] = dj
+	}
+	code := http.StatusOK
+	if !res.Ready {
+		code = http.StatusServiceUnavailable
+	}
+	s.writeJSON(w, code, body)
+}
+
+func (s *HealthService) writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.log.Warnf("write health response: %v", err)
+	}
+}
+
+func servingStatus(r *biz.Readiness) healthpb.HealthCheckResponse_ServingStatus {
+	if r.Ready {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+func (s *HealthService) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !s.services[req.Service] {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.Service)
+	}
+	return &healthpb.HealthCheckResponse{Status: servingStatus(s.health.ReadyCached(ctx, probeMaxAge))}, nil
+}
+
+// Watch 先推送当前状态，之后每隔 watchInterval 检查一次，状态变化时推送；开始关闭时立即推送 NOT_SERVING
+func (s *HealthService) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx := stream.Context()
+	if !s.services[req.Service] {
+		// 按协议约定推送 SERVICE_UNKNOWN 后保持连接，而不是结束调用让客户端当作错误重试
+		if err := stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVICE_UNKNOWN}); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	drained := s.health.Drained()
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		if st := servingStatus(s.health.ReadyCached(ctx, watchInterval)); st != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: st}); err != nil {
+				return err
+			}
+			last = st
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-drained:
+			drained = nil
+		case <-ticker.C:
+		}
+	}
+}
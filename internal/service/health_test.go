@@ -0,0 +1,159 @@
+package service
+
+import (
+	"agdemo/internal/biz"
+	"agdemo/internal/conf"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type fakeDependencyRepo []biz.Dependency
+
+func (r fakeDependencyRepo) Dependencies() []biz.Dependency { return r }
+
+func newTestHealthService(deps ...biz.Dependency) *HealthService {
+	uc := biz.NewHealthUsecase(&conf.Server{}, fakeDependencyRepo(deps), log.DefaultLogger)
+	return NewHealthService(uc, log.DefaultLogger)
+}
+
+// fakeWatchStream 把推送的状态转发到 sent
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan healthpb.HealthCheckResponse_ServingStatus
+}
+
+func (s *fakeWatchStream) Context() context.Context { return s.ctx }
+
+func (s *fakeWatchStream) Send(m *healthpb.HealthCheckResponse) error {
+	s.sent <- m.Status
+	return nil
+}
+
+// watch 在后台调用 Watch，返回推送的状态与 Watch 的返回值
+func watch(ctx context.Context, s *HealthService, service string) (<-chan healthpb.HealthCheckResponse_ServingStatus, <-chan error) {
+	stream := &fakeWatchStream{ctx: ctx, sent: make(chan healthpb.HealthCheckResponse_ServingStatus, 4)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Watch(&healthpb.HealthCheckRequest{Service: service}, stream)
+	}()
+	return stream.sent, done
+}
+
+func expectStatus(t *testing.T, sent <-chan healthpb.HealthCheckResponse_ServingStatus, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	select {
+	case got := <-sent:
+		if got != want {
+			t.Fatalf("status = %s, want %s", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no status pushed, want %s", want)
+	}
+}
+
+func TestWatchDrain(t *testing.T) {
+	s := newTestHealthService(biz.Dependency{Name: "mysql", Ping: func(context.Context) error { return nil }})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sent, _ := watch(ctx, s, "")
+	expectStatus(t, sent, healthpb.HealthCheckResponse_SERVING)
+
+	// 不等下一次定时检查，开始关闭时立即推送
+	if err := s.Drain(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	expectStatus(t, sent, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func TestWatchSharesProbe(t *testing.T) {
+	var pings atomic.Int32
+	s := newTestHealthService(biz.Dependency{Name: "mysql", Ping: func(context.Context) error {
+		pings.Add(1)
+		return nil
+	}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for i := 0; i < 5; i++ {
+		sent, _ := watch(ctx, s, "blog.v1.BlogService")
+		expectStatus(t, sent, healthpb.HealthCheckResponse_SERVING)
+	}
+	if pings.Load() != 1 {
+		t.Fatalf("5 streams pinged %d times, want 1", pings.Load())
+	}
+}
+
+func TestWatchUnknownService(t *testing.T) {
+	s := newTestHealthService()
+	ctx, cancel := context.WithCancel(context.Background())
+	sent, done := watch(ctx, s, "no.such.Service")
+	expectStatus(t, sent, healthpb.HealthCheckResponse_SERVICE_UNKNOWN)
+	select {
+	case err := <-done:
+		t.Fatalf("Watch returned %v, want the stream kept open", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Fatalf("Watch after cancel = %v, want Canceled", err)
+	}
+}
+
+func TestReadinessHidesErrors(t *testing.T) {
+	s := newTestHealthService(
+		biz.Dependency{Name: "mysql", Ping: func(context.Context) error {
+			return errors.New("dial tcp 10.1.2.3:3306: access denied for user 'blog'")
+		}},
+		biz.Dependency{Name: "redis", Ping: func(context.Context) error { return nil }},
+	)
+	rec := httptest.NewRecorder()
+	s.Readiness(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503", rec.Code)
+	}
+	if body := rec.Body.String(); strings.Contains(body, "10.1.2.3") || strings.Contains(body, "denied") {
+		t.Fatalf("body leaks the dependency error: %s", body)
+	}
+	var body readinessJSON
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Status != "not_ready" || body.Dependencies["mysql"].Status != "down" || body.Dependencies["redis"].Status != "up" {
+		t.Fatalf("body = %s", rec.Body.String())
+	}
+}
+
+func TestProbesShareCheck(t *testing.T) {
+	var pings atomic.Int32
+	s := newTestHealthService(biz.Dependency{Name: "mysql", Ping: func(context.Context) error {
+		pings.Add(1)
+		return nil
+	}})
+	for i := 0; i < 5; i++ {
+		rec := httptest.NewRecorder()
+		s.Readiness(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET /readyz = %d, want 200", rec.Code)
+		}
+		res, err := s.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		if err != nil || res.Status != healthpb.HealthCheckResponse_SERVING {
+			t.Fatalf("Check = %v %v", res, err)
+		}
+	}
+	if pings.Load() != 1 {
+		t.Fatalf("10 probes pinged %d times, want 1", pings.Load())
+	}
+}
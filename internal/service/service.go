@@ -8,12 +8,13 @@ import (
 )
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewGreeterService, NewBlogService)
+var ProviderSet = wire.NewSet(NewGreeterService, NewBlogService, NewCommentService, NewUserService, NewApiKeyService, NewHealthService)
 
 type BlogService struct {
 	pb.UnimplementedBlogServiceServer
 
-	article *biz.ArticleUsecase
+	article  *biz.ArticleUsecase
+	taxonomy *biz.TaxonomyUsecase
 
 	log *log.Helper
 }
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"agdemo/internal/biz"
+	"context"
+
+	pb "agdemo/api/blog/v1"
+)
+
+func (s *BlogService) CreateTag(ctx context.Context, req *pb.CreateTagRequest) (*pb.CreateTagReply, error) {
+	t := &biz.Tag{Name: req.Name}
+	if err := s.taxonomy.CreateTag(ctx, t); err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.CreateTagReply{Tag: t.ToProto()}, nil
+}
+
+func (s *BlogService) UpdateTag(ctx context.Context, req *pb.UpdateTagRequest) (*pb.UpdateTagReply, error) {
+	t := &biz.Tag{Id: req.Id, Name: req.Name}
+	if err := s.taxonomy.UpdateTag(ctx, t); err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.UpdateTagReply{Tag: t.ToProto()}, nil
+}
+
+func (s *BlogService) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagReply, error) {
+	if err := s.taxonomy.DeleteTag(ctx, req.Id); err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.DeleteTagReply{}, nil
+}
+
+func (s *BlogService) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsReply, error) {
+	tags, err := s.taxonomy.ListTags(ctx)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	reply := &pb.ListTagsReply{}
+	for _, t := range tags {
+		reply.Results = append(reply.Results, t.ToProto())
+	}
+	return reply, nil
+}
+
+func (s *BlogService) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryReply, error) {
+	c := &biz.Category{Name: req.Name, Description: req.Description}
+	if err := s.taxonomy.CreateCategory(ctx, c); err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.CreateCategoryReply{Category: c.ToProto()}, nil
+}
+
+func (s *BlogService) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryReply, error) {
+	c := &biz.Category{Id: req.Id, Name: req.Name, Description: req.Description}
+	if err := s.taxonomy.UpdateCategory(ctx, c); err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.UpdateCategoryReply{Category: c.ToProto()}, nil
+}
+
+func (s *BlogService) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryReply, error) {
+	if err := s.taxonomy.DeleteCategory(ctx, req.Id); err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	return &pb.DeleteCategoryReply{}, nil
+}
+
+func (s *BlogService) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesReply, error) {
+	categories, err := s.taxonomy.ListCategories(ctx)
+	if err != nil {
+		return nil, s.toStatus(ctx, err)
+	}
+	reply := &pb.ListCategoriesReply{}
+	for _, c := range categories {
+		reply.Results = append(reply.Results, c.ToProto())
+	}
+	return reply, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"agdemo/internal/biz"
+	"context"
+
+	pb "agdemo/api/blog/v1"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type UserService struct {
+	pb.UnimplementedUserServiceServer
+
+	user *biz.UserUsecase
+
+	log *log.Helper
+}
+
+func NewUserService(user *biz.UserUsecase, logger log.Logger) *UserService {
+	return &UserService{user: user, log: log.NewHelper(logger)}
+}
+
+func (s *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterReply, error) {
+	u := &biz.User{Username: req.Username, DisplayName: req.DisplayName}
+	if err := s.user.Register(ctx, u, req.Password); err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.RegisterReply{User: u.ToProto()}, nil
+}
+
+func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginReply, error) {
+	u, token, err := s.user.Login(ctx, req.Username, req.Password)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	reply := &pb.LoginReply{User: u.ToProto()}
+	if token != nil {
+		reply.AccessToken = token.Token
+		reply.ExpiresAt = timestamppb.New(token.ExpiresAt)
+	}
+	return reply, nil
+}
+
+func (s *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserReply, error) {
+	u, err := s.user.Get(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.GetUserReply{User: u.ToProto()}, nil
+}
+
+func (s *UserService) GetMe(ctx context.Context, req *pb.GetMeRequest) (*pb.GetMeReply, error) {
+	u, err := s.user.Me(ctx)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.GetMeReply{User: u.ToProto()}, nil
+}
+
+func (s *UserService) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileReply, error) {
+	u := &biz.User{DisplayName: req.DisplayName, Bio: req.Bio}
+	updated, err := s.user.UpdateProfile(ctx, u, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.UpdateProfileReply{User: updated.ToProto()}, nil
+}
+
+func (s *UserService) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleReply, error) {
+	u, err := s.user.SetRole(ctx, req.Id, req.Role)
+	if err != nil {
+		return nil, toStatus(ctx, s.log, err)
+	}
+	return &pb.SetUserRoleReply{User: u.ToProto()}, nil
+}